@@ -0,0 +1,316 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/6boris/web3-go/consts"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/6boris/web3-go/pkg/pk"
+	"github.com/6boris/web3-go/pkg/signer"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/google/uuid"
+)
+
+var (
+	EntryPointV06Address = common.HexToAddress("0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789")
+	EntryPointV07Address = common.HexToAddress("0x0000000071727De22E5E9d8BAf0edAc6f37da032")
+)
+
+var ErrEntryPointVersionMismatch = errors.New("user operation version does not match the entry point")
+
+// BundlerClient talks to an ERC-4337 bundler for a single EntryPoint, user operations are signed by the
+// signers of the chain client.
+type BundlerClient struct {
+	clientHooks
+	rpcClient     *rpc.Client
+	_chainClient  *EvmClient
+	_transportURL string
+	_entryPoint   common.Address
+	_epVersion    string
+}
+
+func NewBundlerClient(conf *clientModel.ConfEvmBundler, chainClient *EvmClient) (*BundlerClient, error) {
+	var err error
+	bc := &BundlerClient{
+		clientHooks: clientHooks{
+			_clientID:     conf.ClientID,
+			_appID:        chainClient._appID,
+			_zone:         chainClient._zone,
+			_cluster:      chainClient._cluster,
+			_ethChainID:   chainClient._ethChainID,
+			_ethChainName: chainClient._ethChainName,
+			_ethChainEnv:  chainClient._ethChainEnv,
+			_provider:     conf.Provider,
+		},
+		_chainClient:  chainClient,
+		_transportURL: conf.TransportURL,
+		_entryPoint:   conf.EntryPoint,
+		_epVersion:    conf.EntryPointVersion,
+	}
+	if bc._clientID == "" {
+		bc._clientID = strings.ReplaceAll(uuid.NewString(), "-", "")
+	}
+	if bc._entryPoint == (common.Address{}) {
+		bc._entryPoint = EntryPointV07Address
+		if bc._epVersion == consts.EntryPointVersionV06 {
+			bc._entryPoint = EntryPointV06Address
+		}
+	}
+	if bc._epVersion == "" {
+		bc._epVersion = consts.EntryPointVersionV07
+		if bc._entryPoint == EntryPointV06Address {
+			bc._epVersion = consts.EntryPointVersionV06
+		}
+	}
+	if bc._epVersion != consts.EntryPointVersionV06 && bc._epVersion != consts.EntryPointVersionV07 {
+		return nil, fmt.Errorf("entry point version not support: %s", bc._epVersion)
+	}
+	if bc._ethChainID == 0 {
+		chainID, err := chainClient.ChainID(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		bc._ethChainID = chainID.Int64()
+	}
+	bc.rpcClient, err = rpc.Dial(conf.TransportURL)
+	if err != nil {
+		return nil, err
+	}
+	return bc, nil
+}
+
+func (bc *BundlerClient) Close() {
+	bc.rpcClient.Close()
+}
+func (bc *BundlerClient) EntryPoint() common.Address {
+	return bc._entryPoint
+}
+func (bc *BundlerClient) EntryPointVersion() string {
+	return bc._epVersion
+}
+
+func (bc *BundlerClient) SupportedEntryPoints(ctx context.Context) ([]common.Address, error) {
+	abiMethod := consts.EvmBundlerMethodSupportedEntryPoints
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	bc._beforeHooks(ctx, meta)
+	defer func() {
+		bc._afterHooks(ctx, meta)
+	}()
+	var result []common.Address
+	err := bc.rpcClient.CallContext(ctx, &result, "eth_supportedEntryPoints")
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+	}
+	return result, err
+}
+
+func (bc *BundlerClient) EstimateUserOperationGas(ctx context.Context, op clientModel.UserOperation) (*clientModel.UserOperationGasEstimate, error) {
+	abiMethod := consts.EvmBundlerMethodEstimateUserOperationGas
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	bc._beforeHooks(ctx, meta)
+	defer func() {
+		bc._afterHooks(ctx, meta)
+	}()
+	if op.EntryPointVersion() != bc._epVersion {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, ErrEntryPointVersionMismatch
+	}
+	result := &clientModel.UserOperationGasEstimate{}
+	err := bc.rpcClient.CallContext(ctx, result, "eth_estimateUserOperationGas", op, bc._entryPoint)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return result, nil
+}
+
+// SendUserOperation submits a signed op and returns its userOp hash.
+func (bc *BundlerClient) SendUserOperation(ctx context.Context, op clientModel.UserOperation) (common.Hash, error) {
+	abiMethod := consts.EvmBundlerMethodSendUserOperation
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	bc._beforeHooks(ctx, meta)
+	defer func() {
+		bc._afterHooks(ctx, meta)
+	}()
+	if op.EntryPointVersion() != bc._epVersion {
+		meta.Status = consts.AbiCallStatusFail
+		return common.Hash{}, ErrEntryPointVersionMismatch
+	}
+	var result common.Hash
+	err := bc.rpcClient.CallContext(ctx, &result, "eth_sendUserOperation", op, bc._entryPoint)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+	}
+	return result, err
+}
+
+// GetUserOperationReceipt returns ethereum.NotFound while the op is not included yet.
+func (bc *BundlerClient) GetUserOperationReceipt(ctx context.Context, userOpHash common.Hash) (*clientModel.UserOperationReceipt, error) {
+	abiMethod := consts.EvmBundlerMethodGetUserOperationReceipt
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	bc._beforeHooks(ctx, meta)
+	defer func() {
+		bc._afterHooks(ctx, meta)
+	}()
+	var result *clientModel.UserOperationReceipt
+	err := bc.rpcClient.CallContext(ctx, &result, "eth_getUserOperationReceipt", userOpHash)
+	if err == nil && result == nil {
+		err = ethereum.NotFound
+	}
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetUserOperationHash computes the hash of op for the entry point and chain of the client.
+func (bc *BundlerClient) GetUserOperationHash(op clientModel.UserOperation) (common.Hash, error) {
+	if op.EntryPointVersion() != bc._epVersion {
+		return common.Hash{}, ErrEntryPointVersionMismatch
+	}
+	return UserOperationHash(op, bc._entryPoint, big.NewInt(bc._ethChainID))
+}
+
+// SignUserOperation sets the signature of op to the EIP-191 signature of its userOp hash, the scheme
+// of the reference SimpleAccount. Accounts with other schemes sign GetUserOperationHash themselves.
+func (bc *BundlerClient) SignUserOperation(ctx context.Context, account common.Address, op clientModel.UserOperation) error {
+	abiMethod := consts.EvmBundlerMethodSignUserOperation
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	bc._beforeHooks(ctx, meta)
+	defer func() {
+		bc._afterHooks(ctx, meta)
+	}()
+	msgSigner, err := bc._chainClient._getSigner(account)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return err
+	}
+	hash, err := bc.GetUserOperationHash(op)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return err
+	}
+	var signature []byte
+	if opSigner, ok := msgSigner.(signer.UserOperationSigner); ok {
+		signature, err = opSigner.SignUserOperation(ctx, big.NewInt(bc._ethChainID), _userOperationCallData(op), hash)
+	} else if textSigner, ok := msgSigner.(signer.TextSigner); ok {
+		signature, err = textSigner.SignText(ctx, hash.Bytes())
+	} else {
+		signature, err = msgSigner.SignHash(ctx, pk.PersonalMessageHash(hash.Bytes()))
+	}
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return err
+	}
+	switch v := op.(type) {
+	case *clientModel.UserOperationV06:
+		v.Signature = signature
+	case *clientModel.UserOperationV07:
+		v.Signature = signature
+	}
+	return nil
+}
+
+func _userOperationCallData(op clientModel.UserOperation) []byte {
+	switch v := op.(type) {
+	case *clientModel.UserOperationV06:
+		return v.CallData
+	case *clientModel.UserOperationV07:
+		return v.CallData
+	}
+	return nil
+}
+
+// UserOperationHash is keccak256(abi.encode(keccak256(pack(op)), entryPoint, chainID)) as computed by
+// EntryPoint.getUserOpHash of v0.6 and v0.7.
+func UserOperationHash(op clientModel.UserOperation, entryPoint common.Address, chainID *big.Int) (common.Hash, error) {
+	var packed []byte
+	var err error
+	switch v := op.(type) {
+	case *clientModel.UserOperationV06:
+		packed, err = _packUserOperationV06(v)
+	case *clientModel.UserOperationV07:
+		packed, err = _packUserOperationV07(v)
+	default:
+		return common.Hash{}, fmt.Errorf("user operation type not support: %T", op)
+	}
+	if err != nil {
+		return common.Hash{}, err
+	}
+	encoded, err := abi.Arguments{{Type: _abiType("bytes32")}, {Type: _abiType("address")}, {Type: _abiType("uint256")}}.
+		Pack(crypto.Keccak256Hash(packed), entryPoint, chainID)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+func _packUserOperationV06(op *clientModel.UserOperationV06) ([]byte, error) {
+	args := abi.Arguments{
+		{Type: _abiType("address")}, {Type: _abiType("uint256")}, {Type: _abiType("bytes32")}, {Type: _abiType("bytes32")},
+		{Type: _abiType("uint256")}, {Type: _abiType("uint256")}, {Type: _abiType("uint256")}, {Type: _abiType("uint256")},
+		{Type: _abiType("uint256")}, {Type: _abiType("bytes32")},
+	}
+	return args.Pack(
+		op.Sender, _bigOrZero(op.Nonce), crypto.Keccak256Hash(op.InitCode), crypto.Keccak256Hash(op.CallData),
+		_bigOrZero(op.CallGasLimit), _bigOrZero(op.VerificationGasLimit), _bigOrZero(op.PreVerificationGas),
+		_bigOrZero(op.MaxFeePerGas), _bigOrZero(op.MaxPriorityFeePerGas), crypto.Keccak256Hash(op.PaymasterAndData),
+	)
+}
+
+// _packUserOperationV07 packs the unpacked RPC form into the PackedUserOperation fields hashed by v0.7.
+func _packUserOperationV07(op *clientModel.UserOperationV07) ([]byte, error) {
+	var initCode []byte
+	if op.Factory != nil {
+		initCode = append(op.Factory.Bytes(), op.FactoryData...)
+	}
+	var paymasterAndData []byte
+	if op.Paymaster != nil {
+		paymasterAndData = append(paymasterAndData, op.Paymaster.Bytes()...)
+		paymasterAndData = append(paymasterAndData, common.LeftPadBytes(_bigOrZero(op.PaymasterVerificationGasLimit).Bytes(), 16)...)
+		paymasterAndData = append(paymasterAndData, common.LeftPadBytes(_bigOrZero(op.PaymasterPostOpGasLimit).Bytes(), 16)...)
+		paymasterAndData = append(paymasterAndData, op.PaymasterData...)
+	}
+	args := abi.Arguments{
+		{Type: _abiType("address")}, {Type: _abiType("uint256")}, {Type: _abiType("bytes32")}, {Type: _abiType("bytes32")},
+		{Type: _abiType("bytes32")}, {Type: _abiType("uint256")}, {Type: _abiType("bytes32")}, {Type: _abiType("bytes32")},
+	}
+	return args.Pack(
+		op.Sender, _bigOrZero(op.Nonce), crypto.Keccak256Hash(initCode), crypto.Keccak256Hash(op.CallData),
+		_packUint128Pair(op.VerificationGasLimit, op.CallGasLimit), _bigOrZero(op.PreVerificationGas),
+		_packUint128Pair(op.MaxPriorityFeePerGas, op.MaxFeePerGas), crypto.Keccak256Hash(paymasterAndData),
+	)
+}
+
+// _packUint128Pair returns bytes32(high << 128 | low).
+func _packUint128Pair(high *hexutil.Big, low *hexutil.Big) common.Hash {
+	var packed common.Hash
+	copy(packed[:16], common.LeftPadBytes(_bigOrZero(high).Bytes(), 16))
+	copy(packed[16:], common.LeftPadBytes(_bigOrZero(low).Bytes(), 16))
+	return packed
+}
+
+func _bigOrZero(v *hexutil.Big) *big.Int {
+	if v == nil {
+		return new(big.Int)
+	}
+	return v.ToInt()
+}
+
+func _abiType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
@@ -0,0 +1,159 @@
+package client
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/6boris/web3-go/consts"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/6boris/web3-go/pkg/pk"
+	"github.com/6boris/web3-go/pkg/policy"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+// testBundlerService accepts v0.7 user operations signed by owner.
+type testBundlerService struct {
+	testTxService
+	owner    common.Address
+	included map[common.Hash]bool
+}
+
+func (s *testBundlerService) SupportedEntryPoints() []common.Address {
+	return []common.Address{EntryPointV07Address}
+}
+
+func (s *testBundlerService) EstimateUserOperationGas(op clientModel.UserOperationV07, entryPoint common.Address) (*clientModel.UserOperationGasEstimate, error) {
+	if entryPoint != EntryPointV07Address || op.Sender == (common.Address{}) {
+		return nil, errors.New("invalid user operation")
+	}
+	return &clientModel.UserOperationGasEstimate{
+		PreVerificationGas:   (*hexutil.Big)(big.NewInt(50000)),
+		VerificationGasLimit: (*hexutil.Big)(big.NewInt(100000)),
+		CallGasLimit:         (*hexutil.Big)(big.NewInt(30000)),
+	}, nil
+}
+
+func (s *testBundlerService) SendUserOperation(op clientModel.UserOperationV07, entryPoint common.Address) (common.Hash, error) {
+	hash, err := UserOperationHash(&op, entryPoint, big.NewInt(s.chainID))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	signature := common.CopyBytes(op.Signature)
+	if len(signature) != 65 {
+		return common.Hash{}, errors.New("invalid signature length")
+	}
+	signature[64] -= 27
+	pub, err := crypto.SigToPub(pk.PersonalMessageHash(hash.Bytes()), signature)
+	if err != nil || crypto.PubkeyToAddress(*pub) != s.owner {
+		return common.Hash{}, errors.New("AA24 signature error")
+	}
+	s.included[hash] = true
+	return hash, nil
+}
+
+func (s *testBundlerService) GetUserOperationReceipt(hash common.Hash) *clientModel.UserOperationReceipt {
+	if !s.included[hash] {
+		return nil
+	}
+	return &clientModel.UserOperationReceipt{UserOpHash: hash, EntryPoint: EntryPointV07Address, Success: true, ActualGasUsed: (*hexutil.Big)(big.NewInt(120000))}
+}
+
+func TestBundlerClient_Unite(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	assert.Nil(t, err)
+	owner := crypto.PubkeyToAddress(key.PublicKey)
+	service := &testBundlerService{testTxService: testTxService{chainID: 10}, owner: owner, included: map[common.Hash]bool{}}
+
+	allowed := common.HexToAddress("0x6000000000000000000000000000000000000006")
+	policyKey, err := crypto.GenerateKey()
+	assert.Nil(t, err)
+	services := map[string]interface{}{"eth": service}
+	ec := _testEvmClient(t, services, &clientModel.ConfEvmChainClient{
+		Signers: []*clientModel.ConfEvmChainSigner{
+			{PrivateKey: key},
+			{PrivateKey: policyKey, Policy: &clientModel.ConfEvmSignerPolicy{RecipientAllowlist: []common.Address{allowed}}},
+		},
+	})
+	bc, err := NewBundlerClient(&clientModel.ConfEvmBundler{TransportURL: _testRPCServer(t, services)}, ec)
+	assert.Nil(t, err)
+	defer bc.Close()
+	assert.Equal(t, EntryPointV07Address, bc.EntryPoint())
+	assert.Equal(t, consts.EntryPointVersionV07, bc.EntryPointVersion())
+
+	factory := common.HexToAddress("0x9406Cc6185a346906296840746125a0E44976454")
+	op := &clientModel.UserOperationV07{
+		Sender:               common.HexToAddress("0x5000000000000000000000000000000000000005"),
+		Nonce:                (*hexutil.Big)(big.NewInt(0)),
+		Factory:              &factory,
+		FactoryData:          common.FromHex("0x5fbfb9cf"),
+		CallData:             common.FromHex("0xb61d27f6"),
+		MaxFeePerGas:         (*hexutil.Big)(big.NewInt(2e9)),
+		MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(1e9)),
+	}
+
+	t.Run("SupportedEntryPoints", func(t *testing.T) {
+		entryPoints, err := bc.SupportedEntryPoints(testCtx)
+		assert.Nil(t, err)
+		assert.Equal(t, []common.Address{EntryPointV07Address}, entryPoints)
+	})
+	t.Run("UserOperationHash", func(t *testing.T) {
+		packed := _packUint128Pair((*hexutil.Big)(big.NewInt(1)), (*hexutil.Big)(big.NewInt(2)))
+		assert.Equal(t, "0x0000000000000000000000000000000100000000000000000000000000000002", packed.Hex())
+
+		hash, err := bc.GetUserOperationHash(op)
+		assert.Nil(t, err)
+		other, err := UserOperationHash(op, EntryPointV06Address, big.NewInt(10))
+		assert.Nil(t, err)
+		assert.NotEqual(t, hash, other)
+		_, err = bc.GetUserOperationHash(&clientModel.UserOperationV06{})
+		assert.ErrorIs(t, err, ErrEntryPointVersionMismatch)
+	})
+	t.Run("SendUserOperation", func(t *testing.T) {
+		estimate, err := bc.EstimateUserOperationGas(testCtx, op)
+		assert.Nil(t, err)
+		op.CallGasLimit = estimate.CallGasLimit
+		op.VerificationGasLimit = estimate.VerificationGasLimit
+		op.PreVerificationGas = estimate.PreVerificationGas
+
+		hash, err := bc.GetUserOperationHash(op)
+		assert.Nil(t, err)
+		_, err = bc.GetUserOperationReceipt(testCtx, hash)
+		assert.ErrorIs(t, err, ethereum.NotFound)
+
+		assert.Nil(t, bc.SignUserOperation(testCtx, owner, op))
+		sent, err := bc.SendUserOperation(testCtx, op)
+		assert.Nil(t, err)
+		assert.Equal(t, hash, sent)
+		receipt, err := bc.GetUserOperationReceipt(testCtx, hash)
+		assert.Nil(t, err)
+		assert.True(t, receipt.Success)
+
+		op.Nonce = (*hexutil.Big)(big.NewInt(1))
+		_, err = bc.SendUserOperation(testCtx, op)
+		assert.NotNil(t, err)
+	})
+	t.Run("Policy", func(t *testing.T) {
+		accountAbi, err := abi.JSON(strings.NewReader(`[{"type":"function","name":"execute","inputs":[{"name":"dest","type":"address"},{"name":"value","type":"uint256"},{"name":"func","type":"bytes"}],"outputs":[]}]`))
+		assert.Nil(t, err)
+		policyOwner := crypto.PubkeyToAddress(policyKey.PublicKey)
+		op := &clientModel.UserOperationV07{Sender: op.Sender, Nonce: (*hexutil.Big)(big.NewInt(2))}
+
+		op.CallData, err = accountAbi.Pack("execute", allowed, big.NewInt(0), []byte{})
+		assert.Nil(t, err)
+		assert.Nil(t, bc.SignUserOperation(testCtx, policyOwner, op))
+		assert.Len(t, op.Signature, 65)
+
+		op.CallData, err = accountAbi.Pack("execute", common.HexToAddress("0x7000000000000000000000000000000000000007"), big.NewInt(0), []byte{})
+		assert.Nil(t, err)
+		assert.ErrorIs(t, bc.SignUserOperation(testCtx, policyOwner, op), policy.ErrDenied)
+		op.CallData = common.FromHex("0xb61d27f6")
+		assert.ErrorIs(t, bc.SignUserOperation(testCtx, policyOwner, op), policy.ErrDenied)
+	})
+}
@@ -2,12 +2,19 @@ package client
 
 import (
 	"context"
+	"math/big"
+	"net/http/httptest"
 	"os"
 	"testing"
 
 	clientModel "github.com/6boris/web3-go/model/client"
 	"github.com/6boris/web3-go/pkg/pk"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
 )
 
 var (
@@ -56,3 +63,79 @@ func TestMain(m *testing.M) {
 	// After Test
 	os.Exit(code)
 }
+
+// _testRPCServer serves every service under its namespace until the test ends and returns the url.
+func _testRPCServer(t *testing.T, services map[string]interface{}) string {
+	server := rpc.NewServer()
+	for namespace, service := range services {
+		assert.Nil(t, server.RegisterName(namespace, service))
+	}
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+	return httpServer.URL
+}
+
+// _testEvmClient connects a client configured by conf, which may be nil, to a server of services.
+func _testEvmClient(t *testing.T, services map[string]interface{}, conf *clientModel.ConfEvmChainClient) *EvmClient {
+	if conf == nil {
+		conf = &clientModel.ConfEvmChainClient{}
+	}
+	conf.TransportURL = _testRPCServer(t, services)
+	ec, err := NewEvmClient(conf)
+	assert.Nil(t, err)
+	t.Cleanup(ec.Close)
+	return ec
+}
+
+// testTxService is the eth namespace a client needs to sign and send a transaction. It defaults to chain 1,
+// a 1 gwei gas price, 21000 gas and contract code at every address, and records the transactions sent.
+// Tests embed it and override the methods they check.
+type testTxService struct {
+	chainID  int64
+	nonce    uint64
+	gas      uint64
+	gasPrice int64
+	sent     []*types.Transaction
+}
+
+func (s *testTxService) ChainId() hexutil.Big {
+	if s.chainID == 0 {
+		return hexutil.Big(*big.NewInt(1))
+	}
+	return hexutil.Big(*big.NewInt(s.chainID))
+}
+func (s *testTxService) GasPrice() *hexutil.Big {
+	if s.gasPrice == 0 {
+		return (*hexutil.Big)(big.NewInt(1e9))
+	}
+	return (*hexutil.Big)(big.NewInt(s.gasPrice))
+}
+func (s *testTxService) GetTransactionCount(address common.Address, block string) hexutil.Uint64 {
+	_, _ = address, block
+	return hexutil.Uint64(s.nonce)
+}
+func (s *testTxService) EstimateGas(args map[string]interface{}, block *string) hexutil.Uint64 {
+	_, _ = args, block
+	if s.gas == 0 {
+		return 21000
+	}
+	return hexutil.Uint64(s.gas)
+}
+func (s *testTxService) GetCode(address common.Address, block string) hexutil.Bytes {
+	_, _ = address, block
+	return common.FromHex("0x6080")
+}
+func (s *testTxService) SendRawTransaction(input hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return common.Hash{}, err
+	}
+	s.sent = append(s.sent, tx)
+	return tx.Hash(), nil
+}
+func (s *testTxService) _lastSent() *types.Transaction {
+	if len(s.sent) == 0 {
+		return nil
+	}
+	return s.sent[len(s.sent)-1]
+}
@@ -1,10 +1,12 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/6boris/web3-go/consts"
 	clientModel "github.com/6boris/web3-go/model/client"
@@ -109,7 +111,11 @@ func (g *GinMethodConvert) _convertEvmGetBalance(ctx *gin.Context, req *clientMo
 		JsonRpc: req.JsonRpc,
 	}
 
-	account := req.Params[0].(string)
+	account, err := g._resolveAddress(ctx, req.Params[0])
+	if err != nil {
+		ctx.JSON(400, clientModel.ErrReply{Code: 400, Reason: "PARAMS_ERR", Message: err.Error()})
+		return
+	}
 	var blockNumber *big.Int
 	if len(req.Params) > 2 {
 		blockStr, ok := req.Params[1].(string)
@@ -120,7 +126,7 @@ func (g *GinMethodConvert) _convertEvmGetBalance(ctx *gin.Context, req *clientMo
 			}
 		}
 	}
-	ethResp, err := client.BalanceAt(ctx, common.HexToAddress(account), blockNumber)
+	ethResp, err := client.BalanceAt(ctx, account, blockNumber)
 	if err != nil {
 		ctx.JSON(500, &clientModel.ErrReply{Code: 500, Reason: "ETH_ERR", Message: err.Error(), Metadata: map[string]string{"transport_url": client.GetTransportURL()}})
 		return
@@ -129,6 +135,25 @@ func (g *GinMethodConvert) _convertEvmGetBalance(ctx *gin.Context, req *clientMo
 	ctx.JSON(http.StatusOK, resp)
 }
 
+// _resolveAddress accepts a hex address, or an ENS name when the pool is configured with ResolveENS.
+func (g *GinMethodConvert) _resolveAddress(ctx context.Context, param interface{}) (common.Address, error) {
+	value, ok := param.(string)
+	if !ok {
+		return common.Address{}, fmt.Errorf("invalid address: %v", param)
+	}
+	if common.IsHexAddress(value) {
+		return common.HexToAddress(value), nil
+	}
+	if !g.pool.conf.ResolveENS || !strings.Contains(value, ".") {
+		return common.Address{}, fmt.Errorf("invalid address: %s", value)
+	}
+	ensClient := g.pool.GetEvmClient(1)
+	if ensClient == nil {
+		return common.Address{}, ErrEvmClientNotFound
+	}
+	return ensClient.ResolveName(ctx, value)
+}
+
 func (g *GinMethodConvert) _convertSolanaHandler(ctx *gin.Context) {
 	req := &clientModel.SolanaCallProxyRequest{}
 	err := ctx.BindJSON(req)
@@ -2,7 +2,6 @@ package client
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"errors"
 	"math/big"
 	"strings"
@@ -12,6 +11,7 @@ import (
 	"github.com/6boris/web3-go/erc/erc20"
 	clientModel "github.com/6boris/web3-go/model/client"
 	"github.com/6boris/web3-go/pkg/otel"
+	"github.com/6boris/web3-go/pkg/signer"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -24,13 +24,8 @@ import (
 	"go.opentelemetry.io/otel/metric"
 )
 
-type EvmClient struct {
-	ethClient     *ethclient.Client
-	rpcClient     *rpc.Client
-	_signers      []*clientModel.ConfEvmChainSigner
-	_gasLimitMax  decimal.Decimal
-	_gasFeeRate   decimal.Decimal
-	_gasLimitRate decimal.Decimal
+// clientHooks holds the labels of the request metrics, EvmClient and BundlerClient share its hooks.
+type clientHooks struct {
 	_clientID     string
 	_appID        string
 	_zone         string
@@ -39,18 +34,42 @@ type EvmClient struct {
 	_ethChainName string
 	_ethChainEnv  string
 	_provider     string
+}
+
+type EvmClient struct {
+	ethClient     *ethclient.Client
+	rpcClient     *rpc.Client
+	_signers      []signer.Signer
+	_signerIndex  map[common.Address]signer.Signer
+	_relays       map[common.Address]*PrivateRelay
+	_gasLimitMax  decimal.Decimal
+	_gasFeeRate   decimal.Decimal
+	_gasLimitRate decimal.Decimal
+	_accessList   bool
+	clientHooks
 	_transportURL string
+	_nativeSymbol string
+	_chainFamily  string
+	_tokenCache   *TokenRegistry
+	_blockTimes   *blockTimeCache
 }
 
 func NewEvmClient(conf *clientModel.ConfEvmChainClient) (*EvmClient, error) {
 	var err error
 	ec := &EvmClient{
-		_clientID:     strings.ReplaceAll(uuid.NewString(), "-", ""),
-		_provider:     conf.Provider,
+		clientHooks: clientHooks{
+			_clientID: strings.ReplaceAll(uuid.NewString(), "-", ""),
+			_provider: conf.Provider,
+		},
 		_transportURL: conf.TransportURL,
 		_gasFeeRate:   conf.GasFeeRate,
 		_gasLimitRate: conf.GasLimitRate,
 		_gasLimitMax:  conf.GasLimitMax,
+		_accessList:   conf.AutoAccessList,
+		_chainFamily:  conf.ChainFamily,
+		_blockTimes:   newBlockTimeCache(blockTimeCacheSize),
+		_signers:      make([]signer.Signer, 0, len(conf.Signers)),
+		_signerIndex:  make(map[common.Address]signer.Signer, len(conf.Signers)),
 	}
 
 	if ec._gasFeeRate == decimal.Zero {
@@ -62,7 +81,23 @@ func NewEvmClient(conf *clientModel.ConfEvmChainClient) (*EvmClient, error) {
 	if ec._gasLimitMax == decimal.Zero {
 		ec._gasLimitMax = decimal.NewFromFloat(30000000)
 	}
-	ec._signers = conf.Signers
+	for _, v := range conf.Signers {
+		s, loopErr := signer.NewSignerFromConf(context.Background(), v)
+		if loopErr != nil {
+			return nil, loopErr
+		}
+		ec.AddSigner(s)
+		if v.PrivateRelay != nil {
+			relay, loopErr := NewPrivateRelay(v.PrivateRelay)
+			if loopErr != nil {
+				return nil, loopErr
+			}
+			if ec._relays == nil {
+				ec._relays = make(map[common.Address]*PrivateRelay)
+			}
+			ec._relays[s.Address()] = relay
+		}
+	}
 
 	ec.ethClient, err = ethclient.Dial(conf.TransportURL)
 	if err != nil {
@@ -76,45 +111,49 @@ func NewEvmClient(conf *clientModel.ConfEvmChainClient) (*EvmClient, error) {
 	return ec, nil
 }
 
-func (ec *EvmClient) _getSinnerPrivateKey(account common.Address) (*ecdsa.PrivateKey, error) {
-	for _, v := range ec._signers {
-		if v.PublicAddress.String() == account.String() {
-			return v.PrivateKey, nil
-		}
+func (ec *EvmClient) _getSigner(account common.Address) (signer.Signer, error) {
+	if s, ok := ec._signerIndex[account]; ok {
+		return s, nil
 	}
 	return nil, errors.New("signer not config")
 }
-func (ec *EvmClient) _beforeHooks(ctx context.Context, meta *clientModel.Metadata) {
+func (h *clientHooks) _beforeHooks(ctx context.Context, meta *clientModel.Metadata) {
 	_ = ctx
 	meta.StartAt = time.Now()
 }
-func (ec *EvmClient) _afterHooks(ctx context.Context, meta *clientModel.Metadata) {
+func (h *clientHooks) _afterHooks(ctx context.Context, meta *clientModel.Metadata) {
 	otel.MetricsWeb3RequestCounter.Add(ctx, 1, metric.WithAttributes(
-		attribute.Key("client_id").String(ec._clientID),
-		attribute.Key("app_id").String(ec._appID),
-		attribute.Key("zone").String(ec._appID),
-		attribute.Key("cluster").String(ec._cluster),
-		attribute.Key("chain_id").Int64(ec._ethChainID),
-		attribute.Key("chain_name").String(ec._ethChainName),
-		attribute.Key("chain_env").String(ec._ethChainEnv),
-		attribute.Key("provider").String(ec._provider),
+		attribute.Key("client_id").String(h._clientID),
+		attribute.Key("app_id").String(h._appID),
+		attribute.Key("zone").String(h._appID),
+		attribute.Key("cluster").String(h._cluster),
+		attribute.Key("chain_id").Int64(h._ethChainID),
+		attribute.Key("chain_name").String(h._ethChainName),
+		attribute.Key("chain_env").String(h._ethChainEnv),
+		attribute.Key("provider").String(h._provider),
 		attribute.Key("abi_method").String(meta.CallMethod),
 		attribute.Key("status").String(meta.Status),
 	))
 	otel.MetricsWeb3RequestHistogram.Record(ctx, time.Since(meta.StartAt).Milliseconds(), metric.WithAttributes(
-		attribute.Key("client_id").String(ec._clientID),
-		attribute.Key("app_id").String(ec._appID),
-		attribute.Key("zone").String(ec._appID),
-		attribute.Key("cluster").String(ec._cluster),
-		attribute.Key("chain_id").Int64(ec._ethChainID),
-		attribute.Key("chain_env").String(ec._ethChainEnv),
-		attribute.Key("chain_name").String(ec._ethChainName),
-		attribute.Key("provider").String(ec._provider),
+		attribute.Key("client_id").String(h._clientID),
+		attribute.Key("app_id").String(h._appID),
+		attribute.Key("zone").String(h._appID),
+		attribute.Key("cluster").String(h._cluster),
+		attribute.Key("chain_id").Int64(h._ethChainID),
+		attribute.Key("chain_env").String(h._ethChainEnv),
+		attribute.Key("chain_name").String(h._ethChainName),
+		attribute.Key("provider").String(h._provider),
 		attribute.Key("abi_method").String(meta.CallMethod),
 	))
 }
 func (ec *EvmClient) _getTransactOpts(ctx context.Context, signer common.Address, to common.Address, dataHex string) (*bind.TransactOpts, error) {
-	msgSignerPk, err := ec._getSinnerPrivateKey(signer)
+	return ec._getTransactOptsWithMsg(ctx, signer, &to, nil, common.Hex2Bytes(dataHex))
+}
+
+// _getTransactOptsWithMsg estimates against the exact call, to is nil for contract creation and a nil
+// value sends none.
+func (ec *EvmClient) _getTransactOptsWithMsg(ctx context.Context, signer common.Address, to *common.Address, value *big.Int, data []byte) (*bind.TransactOpts, error) {
+	msgSigner, err := ec._getSigner(signer)
 	if err != nil {
 		return nil, err
 	}
@@ -130,36 +169,60 @@ func (ec *EvmClient) _getTransactOpts(ctx context.Context, signer common.Address
 	if err != nil {
 		return nil, err
 	}
-	estimateGas, err := ec.ethClient.EstimateGas(ctx, ethereum.CallMsg{
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	gasPrice = decimal.NewFromBigInt(gasPrice, 0).Mul(ec._gasFeeRate).BigInt()
+	msg := ethereum.CallMsg{
 		From:  signer,
-		To:    &to,
+		To:    to,
 		Gas:   uint64(ec._gasLimitMax.BigInt().Int64()),
-		Value: big.NewInt(0),
-		Data:  common.Hex2Bytes(dataHex),
-	})
-	if err != nil {
-		return nil, err
+		Value: value,
+		Data:  data,
 	}
-	opts, err := bind.NewKeyedTransactorWithChainID(msgSignerPk, chainID)
+	priced := msg
+	priced.GasPrice = gasPrice
+	fee, err := ec._estimateFee(ctx, priced)
 	if err != nil {
 		return nil, err
 	}
+	estimateGas := fee.GasLimit
+	opts := &bind.TransactOpts{
+		From: signer,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != msgSigner.Address() {
+				return nil, bind.ErrNotAuthorized
+			}
+			return msgSigner.SignTx(ctx, tx, chainID)
+		},
+	}
 	opts.Nonce = big.NewInt(int64(nonce))
-	opts.Value = big.NewInt(0)
+	opts.Value = value
 	opts.GasLimit = 0
-	opts.GasPrice = decimal.NewFromBigInt(gasPrice, 0).Mul(ec._gasFeeRate).BigInt()
+	opts.GasPrice = gasPrice
 	opts.Context = ctx
 	if estimateGas > 0 && !ec._gasLimitRate.IsZero() {
 		opts.GasLimit = decimal.NewFromInt(int64(estimateGas)).Mul(ec._gasLimitRate).BigInt().Uint64()
 	}
+	if ec._useAccessList(ctx) {
+		ec._attachAccessList(ctx, opts, msg, estimateGas, chainID)
+	}
 	if !ec._gasLimitMax.IsZero() && opts.GasLimit > uint64(ec._gasLimitMax.BigInt().Int64()) {
 		opts.GasLimit = uint64(ec._gasLimitMax.BigInt().Int64())
 	}
+	if err = ec._checkL1Fee(ctx, opts, fee); err != nil {
+		return nil, err
+	}
 	return opts, nil
 }
-func (ec *EvmClient) _getCallOpts(ctx context.Context) (*bind.CallOpts, error) {
+func (ec *EvmClient) _getCallOpts(ctx context.Context, block BlockRef) (*bind.CallOpts, error) {
+	blockNumber, err := ec.ResolveBlock(ctx, block)
+	if err != nil {
+		return nil, err
+	}
 	opts := &bind.CallOpts{
-		Context: ctx,
+		Context:     ctx,
+		BlockNumber: blockNumber,
 	}
 	return opts, nil
 }
@@ -167,14 +230,36 @@ func (ec *EvmClient) _getCallOpts(ctx context.Context) (*bind.CallOpts, error) {
 func (ec *EvmClient) Close() {
 	ec.ethClient.Close()
 	ec.rpcClient.Close()
+	for _, relay := range ec._relays {
+		relay.Close()
+	}
+	for _, s := range ec._signers {
+		if closer, ok := s.(signer.Closer); ok {
+			closer.Close()
+		}
+	}
 }
 func (ec *EvmClient) GetAllSinners() []common.Address {
 	data := make([]common.Address, 0)
 	for _, v := range ec._signers {
-		data = append(data, v.PublicAddress)
+		data = append(data, v.Address())
 	}
 	return data
 }
+
+// AddSigner registers s for all write paths, replacing any signer already configured for the same address.
+func (ec *EvmClient) AddSigner(s signer.Signer) {
+	if _, ok := ec._signerIndex[s.Address()]; ok {
+		for i, v := range ec._signers {
+			if v.Address() == s.Address() {
+				ec._signers[i] = s
+			}
+		}
+	} else {
+		ec._signers = append(ec._signers, s)
+	}
+	ec._signerIndex[s.Address()] = s
+}
 func (ec *EvmClient) GetTransportURL() string {
 	return ec._transportURL
 }
@@ -291,7 +376,7 @@ func (ec *EvmClient) SendTransaction(ctx context.Context, tx *types.Transaction)
 	defer func() {
 		ec._afterHooks(ctx, meta)
 	}()
-	err := ec.ethClient.SendTransaction(ctx, tx)
+	err := ec._sendTransaction(ctx, tx)
 	if err != nil {
 		meta.Status = consts.AbiCallStatusFail
 	}
@@ -304,29 +389,22 @@ func (ec *EvmClient) SendTransactionSimple(ctx context.Context, signer common.Ad
 	defer func() {
 		ec._afterHooks(ctx, meta)
 	}()
-	msgSignerPk, err := ec._getSinnerPrivateKey(signer)
-	if err != nil {
-		return nil, err
-	}
-	opts, err := ec._getTransactOpts(ctx, signer, to, "0x")
-	if err != nil {
-		return nil, err
-	}
-	chainID, err := ec.ChainID(context.Background())
+	opts, err := ec._getTransactOptsWithMsg(ctx, signer, &to, value, nil)
 	if err != nil {
 		return nil, err
 	}
-	signedTx, err := types.SignNewTx(msgSignerPk, types.NewEIP155Signer(chainID), &types.LegacyTx{
+	// opts.Signer attaches the access list when one was found worthwhile.
+	signedTx, err := opts.Signer(signer, types.NewTx(&types.LegacyTx{
 		To:       &to,
 		Nonce:    opts.Nonce.Uint64(),
 		Value:    value,
 		Gas:      opts.GasLimit,
 		GasPrice: opts.GasPrice,
-	})
+	}))
 	if err != nil {
 		return nil, err
 	}
-	err = ec.ethClient.SendTransaction(ctx, signedTx)
+	err = ec._sendTransaction(ctx, signedTx)
 	if err != nil {
 		meta.Status = consts.AbiCallStatusFail
 	}
@@ -546,69 +624,93 @@ func (ec *EvmClient) NetworkID(ctx context.Context) (*big.Int, error) {
 }
 
 func (ec *EvmClient) ERC20Name(ctx context.Context, token common.Address) (string, error) {
+	return ec.ERC20NameWithBlock(ctx, token, BlockRef{})
+}
+func (ec *EvmClient) ERC20NameWithBlock(ctx context.Context, token common.Address, block BlockRef) (string, error) {
 	abiMethod := consts.EvmErc20MethodName
 	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
 	ec._beforeHooks(ctx, meta)
 	defer func() {
 		ec._afterHooks(ctx, meta)
 	}()
-	inst, err := erc20.NewERC20(token, ec.ethClient)
-	if err != nil {
-		return "", err
+	// Token metadata is only cached as read at the latest block.
+	if block._isZero() {
+		if data, ok := ec._cachedTokenMetadata(ctx, token); ok {
+			return data.Name, nil
+		}
 	}
-	opts, err := ec._getCallOpts(ctx)
+	blockNumber, err := ec.ResolveBlock(ctx, block)
 	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
 		return "", err
 	}
-	callResp, err := inst.Name(opts)
+	callResp, err := ec._erc20String(ctx, token, "name", blockNumber)
 	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
 		return "", err
 	}
 	return callResp, nil
 }
 func (ec *EvmClient) ERC20Symbol(ctx context.Context, token common.Address) (string, error) {
+	return ec.ERC20SymbolWithBlock(ctx, token, BlockRef{})
+}
+func (ec *EvmClient) ERC20SymbolWithBlock(ctx context.Context, token common.Address, block BlockRef) (string, error) {
 	abiMethod := consts.EvmErc20MethodSymbol
 	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
 	ec._beforeHooks(ctx, meta)
 	defer func() {
 		ec._afterHooks(ctx, meta)
 	}()
-	inst, err := erc20.NewERC20(token, ec.ethClient)
-	if err != nil {
-		return "", err
+	// Token metadata is only cached as read at the latest block.
+	if block._isZero() {
+		if data, ok := ec._cachedTokenMetadata(ctx, token); ok {
+			return data.Symbol, nil
+		}
 	}
-	opts, err := ec._getCallOpts(ctx)
+	blockNumber, err := ec.ResolveBlock(ctx, block)
 	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
 		return "", err
 	}
-	callResp, err := inst.Symbol(opts)
+	callResp, err := ec._erc20String(ctx, token, "symbol", blockNumber)
 	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
 		return "", err
 	}
 	return callResp, nil
 }
 func (ec *EvmClient) ERC20Decimals(ctx context.Context, token common.Address) (uint8, error) {
+	return ec.ERC20DecimalsWithBlock(ctx, token, BlockRef{})
+}
+func (ec *EvmClient) ERC20DecimalsWithBlock(ctx context.Context, token common.Address, block BlockRef) (uint8, error) {
 	abiMethod := consts.EvmErc20MethodDecimals
 	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
 	ec._beforeHooks(ctx, meta)
 	defer func() {
 		ec._afterHooks(ctx, meta)
 	}()
-	inst, err := erc20.NewERC20(token, ec.ethClient)
-	if err != nil {
-		return 0, err
+	// Token metadata is only cached as read at the latest block.
+	if block._isZero() {
+		if data, ok := ec._cachedTokenMetadata(ctx, token); ok {
+			return data.Decimals, nil
+		}
 	}
-	opts, err := ec._getCallOpts(ctx)
+	blockNumber, err := ec.ResolveBlock(ctx, block)
 	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
 		return 0, err
 	}
-	callResp, err := inst.Decimals(opts)
+	callResp, err := ec._erc20Decimals(ctx, token, blockNumber)
 	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
 		return 0, err
 	}
 	return callResp, nil
 }
 func (ec *EvmClient) ERC20BalanceOf(ctx context.Context, token common.Address, account common.Address) (*big.Int, error) {
+	return ec.ERC20BalanceOfWithBlock(ctx, token, account, BlockRef{})
+}
+func (ec *EvmClient) ERC20BalanceOfWithBlock(ctx context.Context, token common.Address, account common.Address, block BlockRef) (*big.Int, error) {
 	abiMethod := consts.EvmErc20MethodBalanceOf
 	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
 	ec._beforeHooks(ctx, meta)
@@ -619,7 +721,7 @@ func (ec *EvmClient) ERC20BalanceOf(ctx context.Context, token common.Address, a
 	if err != nil {
 		return big.NewInt(0), err
 	}
-	opts, err := ec._getCallOpts(ctx)
+	opts, err := ec._getCallOpts(ctx, block)
 	if err != nil {
 		return big.NewInt(0), err
 	}
@@ -630,6 +732,9 @@ func (ec *EvmClient) ERC20BalanceOf(ctx context.Context, token common.Address, a
 	return callResp, nil
 }
 func (ec *EvmClient) ERC20TotalSupply(ctx context.Context, token common.Address) (*big.Int, error) {
+	return ec.ERC20TotalSupplyWithBlock(ctx, token, BlockRef{})
+}
+func (ec *EvmClient) ERC20TotalSupplyWithBlock(ctx context.Context, token common.Address, block BlockRef) (*big.Int, error) {
 	abiMethod := consts.EvmErc20MethodTotalSupply
 	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
 	ec._beforeHooks(ctx, meta)
@@ -640,7 +745,7 @@ func (ec *EvmClient) ERC20TotalSupply(ctx context.Context, token common.Address)
 	if err != nil {
 		return big.NewInt(0), err
 	}
-	opts, err := ec._getCallOpts(ctx)
+	opts, err := ec._getCallOpts(ctx, block)
 	if err != nil {
 		return big.NewInt(0), err
 	}
@@ -657,7 +762,7 @@ func (ec *EvmClient) ERC20Transfer(ctx context.Context, token common.Address, si
 	defer func() {
 		ec._afterHooks(ctx, meta)
 	}()
-	inst, err := erc20.NewERC20(token, ec.ethClient)
+	inst, err := erc20.NewERC20(token, ec._contractBackend())
 	if err != nil {
 		return nil, err
 	}
@@ -688,7 +793,7 @@ func (ec *EvmClient) ERC20Approve(ctx context.Context, token common.Address, sig
 	defer func() {
 		ec._afterHooks(ctx, meta)
 	}()
-	inst, err := erc20.NewERC20(token, ec.ethClient)
+	inst, err := erc20.NewERC20(token, ec._contractBackend())
 	if err != nil {
 		return nil, err
 	}
@@ -696,7 +801,7 @@ func (ec *EvmClient) ERC20Approve(ctx context.Context, token common.Address, sig
 	if err != nil {
 		return nil, err
 	}
-	abiData, err := abi.Pack("transfer", to, value)
+	abiData, err := abi.Pack("approve", to, value)
 	if err != nil {
 		return nil, err
 	}
@@ -717,7 +822,7 @@ func (ec *EvmClient) ERC20IncreaseAllowance(ctx context.Context, token common.Ad
 	defer func() {
 		ec._afterHooks(ctx, meta)
 	}()
-	inst, err := erc20.NewERC20(token, ec.ethClient)
+	inst, err := erc20.NewERC20(token, ec._contractBackend())
 	if err != nil {
 		return nil, err
 	}
@@ -746,7 +851,7 @@ func (ec *EvmClient) ERC20DecreaseAllowance(ctx context.Context, token common.Ad
 	defer func() {
 		ec._afterHooks(ctx, meta)
 	}()
-	inst, err := erc20.NewERC20(token, ec.ethClient)
+	inst, err := erc20.NewERC20(token, ec._contractBackend())
 	if err != nil {
 		return nil, err
 	}
@@ -769,6 +874,9 @@ func (ec *EvmClient) ERC20DecreaseAllowance(ctx context.Context, token common.Ad
 	return callResp, nil
 }
 func (ec *EvmClient) ERC20Allowance(ctx context.Context, token common.Address, owner common.Address, spender common.Address) (*big.Int, error) {
+	return ec.ERC20AllowanceWithBlock(ctx, token, owner, spender, BlockRef{})
+}
+func (ec *EvmClient) ERC20AllowanceWithBlock(ctx context.Context, token common.Address, owner common.Address, spender common.Address, block BlockRef) (*big.Int, error) {
 	abiMethod := consts.EvmErc20MethodAllowance
 	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
 	ec._beforeHooks(ctx, meta)
@@ -779,7 +887,7 @@ func (ec *EvmClient) ERC20Allowance(ctx context.Context, token common.Address, o
 	if err != nil {
 		return big.NewInt(0), err
 	}
-	opts, err := ec._getCallOpts(ctx)
+	opts, err := ec._getCallOpts(ctx, block)
 	if err != nil {
 		return big.NewInt(0), err
 	}
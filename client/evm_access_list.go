@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/6boris/web3-go/consts"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/shopspring/decimal"
+)
+
+type _accessListKey struct{}
+
+type AccessListResult struct {
+	AccessList types.AccessList
+	// GasUsed is the gas used by the call with AccessList attached.
+	GasUsed uint64
+}
+
+// CreateAccessList returns the storage slots and addresses msg touches on the pending state.
+func (ec *EvmClient) CreateAccessList(ctx context.Context, msg ethereum.CallMsg) (*AccessListResult, error) {
+	abiMethod := consts.EvmMethodCreateAccessList
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	result, err := ec._createAccessList(ctx, msg)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return result, nil
+}
+
+func (ec *EvmClient) _createAccessList(ctx context.Context, msg ethereum.CallMsg) (*AccessListResult, error) {
+	var result struct {
+		AccessList *types.AccessList `json:"accessList"`
+		GasUsed    hexutil.Uint64    `json:"gasUsed"`
+		Error      string            `json:"error,omitempty"`
+	}
+	if err := ec.rpcClient.CallContext(ctx, &result, "eth_createAccessList", _toCallArg(msg), "pending"); err != nil {
+		return nil, err
+	}
+	if result.Error != "" {
+		return nil, errors.New(result.Error)
+	}
+	accessList := types.AccessList{}
+	if result.AccessList != nil {
+		accessList = *result.AccessList
+	}
+	return &AccessListResult{AccessList: accessList, GasUsed: uint64(result.GasUsed)}, nil
+}
+
+// WithAccessList makes the send methods called with the returned context attach an EIP-2930 access list
+// when it lowers the estimated gas, or never attach one, whatever the AutoAccessList of the client.
+func WithAccessList(ctx context.Context, enabled bool) context.Context {
+	return context.WithValue(ctx, _accessListKey{}, enabled)
+}
+
+func (ec *EvmClient) _useAccessList(ctx context.Context) bool {
+	if enabled, ok := ctx.Value(_accessListKey{}).(bool); ok {
+		return enabled
+	}
+	return ec._accessList
+}
+
+// _attachAccessList re-estimates msg with its access list and, when that is cheaper, lowers opts.GasLimit
+// and makes opts.Signer send an EIP-2930 transaction instead of a legacy one. The access list only saves
+// gas, so when the node cannot create or estimate it the transaction is sent without one, the failures
+// are still recorded by the CreateAccessList and EstimateGas metrics.
+func (ec *EvmClient) _attachAccessList(ctx context.Context, opts *bind.TransactOpts, msg ethereum.CallMsg, estimateGas uint64, chainID *big.Int) {
+	created, err := ec.CreateAccessList(ctx, msg)
+	if err != nil || len(created.AccessList) == 0 {
+		return
+	}
+	msg.AccessList = created.AccessList
+	accessListGas, err := ec.EstimateGas(ctx, msg)
+	if err != nil || accessListGas >= estimateGas {
+		return
+	}
+	if !ec._gasLimitRate.IsZero() {
+		opts.GasLimit = decimal.NewFromInt(int64(accessListGas)).Mul(ec._gasLimitRate).BigInt().Uint64()
+	}
+	legacySigner := opts.Signer
+	opts.Signer = func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if tx.Type() != types.LegacyTxType {
+			return legacySigner(address, tx)
+		}
+		return legacySigner(address, types.NewTx(&types.AccessListTx{
+			ChainID:    chainID,
+			Nonce:      tx.Nonce(),
+			GasPrice:   tx.GasPrice(),
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: created.AccessList,
+		}))
+	}
+}
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/6boris/web3-go/pkg/pk"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+var testAccessList = types.AccessList{{
+	Address:     common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"),
+	StorageKeys: []common.Hash{common.HexToHash("0x01"), common.HexToHash("0x02")},
+}}
+
+// testAccessListService charges 30000 gas for a call, 28000 with an access list attached.
+type testAccessListService struct {
+	testTxService
+	created     map[string]interface{}
+	createErr   error
+	estimateErr error
+}
+
+func (s *testAccessListService) CreateAccessList(args map[string]interface{}, block string) (map[string]interface{}, error) {
+	_ = block
+	s.created = args
+	if s.createErr != nil {
+		return nil, s.createErr
+	}
+	if args["input"] == "0xdead" {
+		return map[string]interface{}{"accessList": []interface{}{}, "gasUsed": "0x5208", "error": "execution reverted"}, nil
+	}
+	return map[string]interface{}{"accessList": testAccessList, "gasUsed": hexutil.Uint64(26000)}, nil
+}
+
+func (s *testAccessListService) EstimateGas(args map[string]interface{}, block *string) (hexutil.Uint64, error) {
+	_ = block
+	if _, ok := args["accessList"]; ok {
+		if s.estimateErr != nil {
+			return 0, s.estimateErr
+		}
+		return 28000, nil
+	}
+	return 30000, nil
+}
+
+func TestEvmClient_Unite_AccessList(t *testing.T) {
+	service := &testAccessListService{testTxService: testTxService{nonce: 1}}
+	signer, err := pk.TransformPkToEvmSigner(hex.EncodeToString(crypto.Keccak256([]byte("access"))))
+	assert.Nil(t, err)
+	newClient := func(autoAccessList bool) *EvmClient {
+		return _testEvmClient(t, map[string]interface{}{"eth": service}, &clientModel.ConfEvmChainClient{
+			GasFeeRate:     decimal.NewFromInt(1),
+			GasLimitRate:   decimal.NewFromInt(2),
+			AutoAccessList: autoAccessList,
+			Signers:        []*clientModel.ConfEvmChainSigner{signer},
+		})
+	}
+	ec, plain := newClient(true), newClient(false)
+	token := testAccessList[0].Address
+	to := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+	t.Run("CreateAccessList", func(t *testing.T) {
+		result, err := ec.CreateAccessList(testCtx, ethereum.CallMsg{From: signer.PublicAddress, To: &token, Data: common.FromHex("0xa9059cbb")})
+		assert.Nil(t, err)
+		assert.Equal(t, testAccessList, result.AccessList)
+		assert.Equal(t, uint64(26000), result.GasUsed)
+		_, err = ec.CreateAccessList(testCtx, ethereum.CallMsg{From: signer.PublicAddress, To: &token, Data: common.FromHex("0xdead")})
+		assert.EqualError(t, err, "execution reverted")
+	})
+	t.Run("ERC20Transfer", func(t *testing.T) {
+		tx, err := ec.ERC20Transfer(testCtx, token, signer.PublicAddress, to, big.NewInt(1))
+		assert.Nil(t, err)
+		assert.Equal(t, tx.Hash(), service._lastSent().Hash())
+		assert.Equal(t, uint8(types.AccessListTxType), service._lastSent().Type())
+		assert.Equal(t, testAccessList, service._lastSent().AccessList())
+		assert.Equal(t, uint64(56000), service._lastSent().Gas())
+		sender, err := types.Sender(types.LatestSignerForChainID(big.NewInt(1)), service._lastSent())
+		assert.Nil(t, err)
+		assert.Equal(t, signer.PublicAddress, sender)
+
+		_, err = plain.ERC20Transfer(testCtx, token, signer.PublicAddress, to, big.NewInt(1))
+		assert.Nil(t, err)
+		assert.Equal(t, uint8(types.LegacyTxType), service._lastSent().Type())
+		assert.Equal(t, uint64(60000), service._lastSent().Gas())
+	})
+	t.Run("SendTransactionSimple", func(t *testing.T) {
+		_, err := ec.SendTransactionSimple(testCtx, signer.PublicAddress, to, big.NewInt(1))
+		assert.Nil(t, err)
+		assert.Equal(t, uint8(types.AccessListTxType), service._lastSent().Type())
+		assert.Equal(t, int64(1), service._lastSent().Value().Int64())
+		assert.Equal(t, "0x1", service.created["value"])
+	})
+	t.Run("ERC20Approve", func(t *testing.T) {
+		_, err := ec.ERC20Approve(testCtx, token, signer.PublicAddress, to, big.NewInt(1))
+		assert.Nil(t, err)
+		assert.Equal(t, uint8(types.AccessListTxType), service._lastSent().Type())
+		assert.True(t, strings.HasPrefix(service.created["input"].(string), "0x095ea7b3"))
+		assert.Equal(t, service.created["input"], hexutil.Encode(service._lastSent().Data()))
+	})
+	t.Run("Fallback", func(t *testing.T) {
+		service.createErr = errors.New("the method eth_createAccessList does not exist/is not available")
+		_, err := ec.ERC20Transfer(testCtx, token, signer.PublicAddress, to, big.NewInt(1))
+		service.createErr = nil
+		assert.Nil(t, err)
+		assert.Equal(t, uint8(types.LegacyTxType), service._lastSent().Type())
+		assert.Equal(t, uint64(60000), service._lastSent().Gas())
+
+		service.estimateErr = errors.New("execution reverted")
+		_, err = ec.ERC20Transfer(testCtx, token, signer.PublicAddress, to, big.NewInt(1))
+		service.estimateErr = nil
+		assert.Nil(t, err)
+		assert.Equal(t, uint8(types.LegacyTxType), service._lastSent().Type())
+		assert.Equal(t, uint64(60000), service._lastSent().Gas())
+	})
+	t.Run("WithAccessList", func(t *testing.T) {
+		_, err := plain.ERC20Transfer(WithAccessList(testCtx, true), token, signer.PublicAddress, to, big.NewInt(1))
+		assert.Nil(t, err)
+		assert.Equal(t, uint8(types.AccessListTxType), service._lastSent().Type())
+		_, err = ec.ERC20Transfer(WithAccessList(testCtx, false), token, signer.PublicAddress, to, big.NewInt(1))
+		assert.Nil(t, err)
+		assert.Equal(t, uint8(types.LegacyTxType), service._lastSent().Type())
+	})
+}
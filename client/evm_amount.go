@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+	"math/big"
+
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/6boris/web3-go/pkg/units"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BalanceAtAmount is BalanceAt paired with the native currency metadata of the chain.
+func (ec *EvmClient) BalanceAtAmount(ctx context.Context, account common.Address, blockNumber *big.Int) (*units.TokenAmount, error) {
+	balance, err := ec.BalanceAt(ctx, account, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	return units.NewTokenAmount(balance, &clientModel.TokenMetadata{
+		ChainID:  ec._ethChainID,
+		Name:     ec._nativeSymbol,
+		Symbol:   ec._nativeSymbol,
+		Decimals: uint8(units.EtherDecimals),
+	}), nil
+}
+
+// ERC20BalanceOfAmount is ERC20BalanceOf paired with the token metadata, taken from the Pool token registry when cached.
+func (ec *EvmClient) ERC20BalanceOfAmount(ctx context.Context, token common.Address, account common.Address) (*units.TokenAmount, error) {
+	metadata, err := ec.ERC20Metadata(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := ec.ERC20BalanceOf(ctx, token, account)
+	if err != nil {
+		return nil, err
+	}
+	return units.NewTokenAmount(balance, metadata), nil
+}
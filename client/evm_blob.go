@@ -0,0 +1,208 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/6boris/web3-go/consts"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// BlobDataPerFieldElement keeps the top byte of every field element zero so it stays below the BLS modulus.
+	BlobDataPerFieldElement = 31
+	BlobDataPerBlob         = params.BlobTxFieldElementsPerBlob * BlobDataPerFieldElement
+	BlobMaxPerTransaction   = params.MaxBlobGasPerBlock / params.BlobTxBlobGasPerBlob
+)
+
+var (
+	ErrBlobDataEmpty      = errors.New("blob data is empty")
+	ErrBlobDataTooLarge   = errors.New("blob data exceeds the blobs of a transaction")
+	ErrBlobFeeUnsupported = errors.New("fee history has no blob base fee, chain is not on cancun")
+)
+
+// EncodeBlobs packs data into blobs, 31 bytes per 32 byte field element. The last blob is zero padded.
+func EncodeBlobs(data []byte) ([]kzg4844.Blob, error) {
+	if len(data) == 0 {
+		return nil, ErrBlobDataEmpty
+	}
+	count := (len(data) + BlobDataPerBlob - 1) / BlobDataPerBlob
+	if count > BlobMaxPerTransaction {
+		return nil, fmt.Errorf("%w: %d bytes", ErrBlobDataTooLarge, len(data))
+	}
+	blobs := make([]kzg4844.Blob, count)
+	for i := 0; len(data) > 0; i++ {
+		blob, element := i/params.BlobTxFieldElementsPerBlob, i%params.BlobTxFieldElementsPerBlob
+		n := copy(blobs[blob][element*32+1:(element+1)*32], data)
+		data = data[n:]
+	}
+	return blobs, nil
+}
+
+// NewBlobTxSidecar encodes data into blobs and computes their KZG commitments and proofs.
+func NewBlobTxSidecar(data []byte) (*types.BlobTxSidecar, error) {
+	blobs, err := EncodeBlobs(data)
+	if err != nil {
+		return nil, err
+	}
+	sidecar := &types.BlobTxSidecar{
+		Blobs:       blobs,
+		Commitments: make([]kzg4844.Commitment, len(blobs)),
+		Proofs:      make([]kzg4844.Proof, len(blobs)),
+	}
+	for i := range blobs {
+		if sidecar.Commitments[i], err = kzg4844.BlobToCommitment(&blobs[i]); err != nil {
+			return nil, err
+		}
+		if sidecar.Proofs[i], err = kzg4844.ComputeBlobProof(&blobs[i], sidecar.Commitments[i]); err != nil {
+			return nil, err
+		}
+	}
+	return sidecar, nil
+}
+
+// SuggestBlobGasFeeCap suggests maxFeePerBlobGas, twice the blob base fee of the next block as reported
+// by FeeHistory, which absorbs roughly six full blocks of blob fee increase.
+func (ec *EvmClient) SuggestBlobGasFeeCap(ctx context.Context) (*big.Int, error) {
+	abiMethod := consts.EvmMethodSuggestBlobGasFeeCap
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	_, blobBaseFee, err := ec._blobBaseFees(ctx)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return new(big.Int).Mul(blobBaseFee, big.NewInt(2)), nil
+}
+
+// BuildBlobTransaction signs a blob transaction carrying blobData in its sidecar. The execution fee cap
+// is twice the next base fee plus the suggested tip.
+func (ec *EvmClient) BuildBlobTransaction(ctx context.Context, signer common.Address, to common.Address, data []byte, blobData []byte) (*types.Transaction, error) {
+	abiMethod := consts.EvmMethodBuildBlobTransaction
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	signedTx, err := ec._buildBlobTransaction(ctx, signer, to, data, blobData)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return signedTx, nil
+}
+
+// SendBlobTransaction builds and sends a blob transaction in its network form, with the sidecar attached.
+func (ec *EvmClient) SendBlobTransaction(ctx context.Context, signer common.Address, to common.Address, data []byte, blobData []byte) (*types.Transaction, error) {
+	abiMethod := consts.EvmMethodSendBlobTransaction
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	signedTx, err := ec._buildBlobTransaction(ctx, signer, to, data, blobData)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	if err = ec._sendTransaction(ctx, signedTx); err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return signedTx, nil
+}
+
+func (ec *EvmClient) _buildBlobTransaction(ctx context.Context, signer common.Address, to common.Address, data []byte, blobData []byte) (*types.Transaction, error) {
+	msgSigner, err := ec._getSigner(signer)
+	if err != nil {
+		return nil, err
+	}
+	sidecar, err := NewBlobTxSidecar(blobData)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := ec.ethClient.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := ec.ethClient.PendingNonceAt(ctx, signer)
+	if err != nil {
+		return nil, err
+	}
+	baseFee, blobBaseFee, err := ec._blobBaseFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gasTipCap, err := ec.ethClient.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gasFeeCap := new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), gasTipCap)
+	blobFeeCap := new(big.Int).Mul(blobBaseFee, big.NewInt(2))
+	blobHashes := sidecar.BlobHashes()
+	estimateGas, err := ec.ethClient.EstimateGas(ctx, ethereum.CallMsg{
+		From:          signer,
+		To:            &to,
+		GasFeeCap:     gasFeeCap,
+		GasTipCap:     gasTipCap,
+		Data:          data,
+		BlobGasFeeCap: blobFeeCap,
+		BlobHashes:    blobHashes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	gas := estimateGas
+	if !ec._gasLimitRate.IsZero() {
+		gas = decimal.NewFromInt(int64(estimateGas)).Mul(ec._gasLimitRate).BigInt().Uint64()
+	}
+	if !ec._gasLimitMax.IsZero() && gas > uint64(ec._gasLimitMax.BigInt().Int64()) {
+		gas = uint64(ec._gasLimitMax.BigInt().Int64())
+	}
+	return msgSigner.SignTx(ctx, types.NewTx(&types.BlobTx{
+		ChainID:    uint256.MustFromBig(chainID),
+		Nonce:      nonce,
+		GasTipCap:  uint256.MustFromBig(gasTipCap),
+		GasFeeCap:  uint256.MustFromBig(gasFeeCap),
+		Gas:        gas,
+		To:         to,
+		Value:      new(uint256.Int),
+		Data:       data,
+		BlobFeeCap: uint256.MustFromBig(blobFeeCap),
+		BlobHashes: blobHashes,
+		Sidecar:    sidecar,
+	}), chainID)
+}
+
+// _blobBaseFees returns the base fee and blob base fee of the next block. ethereum.FeeHistory does not
+// carry the blob fields yet, so eth_feeHistory is called directly.
+func (ec *EvmClient) _blobBaseFees(ctx context.Context) (*big.Int, *big.Int, error) {
+	var result struct {
+		BaseFee     []*hexutil.Big `json:"baseFeePerGas"`
+		BlobBaseFee []*hexutil.Big `json:"baseFeePerBlobGas"`
+	}
+	if err := ec.rpcClient.CallContext(ctx, &result, "eth_feeHistory", hexutil.Uint64(1), "latest", []float64{}); err != nil {
+		return nil, nil, err
+	}
+	if len(result.BaseFee) == 0 || len(result.BlobBaseFee) == 0 {
+		return nil, nil, ErrBlobFeeUnsupported
+	}
+	baseFee, blobBaseFee := result.BaseFee[len(result.BaseFee)-1], result.BlobBaseFee[len(result.BlobBaseFee)-1]
+	if baseFee == nil || blobBaseFee == nil || blobBaseFee.ToInt().Sign() == 0 {
+		return nil, nil, ErrBlobFeeUnsupported
+	}
+	return baseFee.ToInt(), blobBaseFee.ToInt(), nil
+}
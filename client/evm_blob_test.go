@@ -0,0 +1,90 @@
+package client
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/6boris/web3-go/pkg/pk"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+type testBlobService struct {
+	testTxService
+}
+
+func (s *testBlobService) FeeHistory(blockCount hexutil.Uint64, lastBlock string, percentiles []float64) map[string]interface{} {
+	_, _, _ = blockCount, lastBlock, percentiles
+	return map[string]interface{}{
+		"oldestBlock":       "0x10",
+		"baseFeePerGas":     []string{"0x3b9aca00", "0x77359400"},
+		"gasUsedRatio":      []float64{0.5},
+		"baseFeePerBlobGas": []string{"0x1", "0x3"},
+		"blobGasUsedRatio":  []float64{0.5},
+	}
+}
+
+func (s *testBlobService) MaxPriorityFeePerGas() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(1e8))
+}
+
+func TestEvmClient_Unite_Blob(t *testing.T) {
+	service := &testBlobService{testTxService: testTxService{nonce: 3}}
+	signer, err := pk.TransformPkToEvmSigner(hex.EncodeToString(crypto.Keccak256([]byte("blob"))))
+	assert.Nil(t, err)
+	ec := _testEvmClient(t, map[string]interface{}{"eth": service}, &clientModel.ConfEvmChainClient{
+		GasLimitRate: decimal.NewFromInt(2),
+		Signers:      []*clientModel.ConfEvmChainSigner{signer},
+	})
+	to := common.HexToAddress("0xff00000000000000000000000000000000010000")
+
+	t.Run("EncodeBlobs", func(t *testing.T) {
+		_, err := EncodeBlobs(nil)
+		assert.ErrorIs(t, err, ErrBlobDataEmpty)
+		_, err = EncodeBlobs(make([]byte, BlobDataPerBlob*BlobMaxPerTransaction+1))
+		assert.ErrorIs(t, err, ErrBlobDataTooLarge)
+
+		data := bytes.Repeat([]byte{0xff}, BlobDataPerBlob+40)
+		blobs, err := EncodeBlobs(data)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(blobs))
+		assert.Equal(t, byte(0), blobs[0][0])
+		assert.Equal(t, data[:31], blobs[0][1:32])
+		assert.Equal(t, byte(0), blobs[0][32])
+		assert.Equal(t, data[BlobDataPerBlob:BlobDataPerBlob+31], blobs[1][1:32])
+		assert.Equal(t, data[BlobDataPerBlob+31:], blobs[1][33:42])
+		assert.Equal(t, make([]byte, 32), blobs[1][64:96])
+	})
+	t.Run("SuggestBlobGasFeeCap", func(t *testing.T) {
+		feeCap, err := ec.SuggestBlobGasFeeCap(testCtx)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(6), feeCap.Int64())
+	})
+	t.Run("SendBlobTransaction", func(t *testing.T) {
+		tx, err := ec.SendBlobTransaction(testCtx, signer.PublicAddress, to, nil, []byte("rollup batch"))
+		assert.Nil(t, err)
+		assert.Equal(t, tx.Hash(), service._lastSent().Hash())
+		assert.Equal(t, uint8(types.BlobTxType), service._lastSent().Type())
+		assert.Equal(t, uint64(3), service._lastSent().Nonce())
+		assert.Equal(t, int64(6), service._lastSent().BlobGasFeeCap().Int64())
+		assert.Equal(t, int64(2*2e9+1e8), service._lastSent().GasFeeCap().Int64())
+		assert.Equal(t, uint64(42000), service._lastSent().Gas())
+		sender, err := types.Sender(types.LatestSignerForChainID(big.NewInt(1)), service._lastSent())
+		assert.Nil(t, err)
+		assert.Equal(t, signer.PublicAddress, sender)
+
+		sidecar := service._lastSent().BlobTxSidecar()
+		assert.NotNil(t, sidecar)
+		assert.Equal(t, service._lastSent().BlobHashes(), sidecar.BlobHashes())
+		assert.True(t, kzg4844.IsValidVersionedHash(service._lastSent().BlobHashes()[0].Bytes()))
+		assert.Nil(t, kzg4844.VerifyBlobProof(&sidecar.Blobs[0], sidecar.Commitments[0], sidecar.Proofs[0]))
+	})
+}
@@ -0,0 +1,168 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/6boris/web3-go/consts"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var ErrBlockBeforeGenesis = errors.New("timestamp is before the genesis block")
+
+// blockTimeCacheSize bounds the header timestamps a client keeps for BlockNumberByTimestamp.
+const blockTimeCacheSize = 8192
+
+// BlockRef selects the block a read runs against: a block number, a tag such as latest, safe or
+// finalized, or the last block mined at or before a timestamp. The zero value is latest. Contract reads
+// take it in their WithBlock variant, such as ERC20BalanceOfWithBlock, the methods taking a block number
+// get it from ResolveBlock. Writes always read the latest state.
+type BlockRef struct {
+	Number    *big.Int
+	Tag       string
+	Timestamp time.Time
+}
+
+func BlockAtNumber(number *big.Int) BlockRef {
+	return BlockRef{Number: number}
+}
+func BlockAtTag(tag string) BlockRef {
+	return BlockRef{Tag: tag}
+}
+func BlockAtTime(timestamp time.Time) BlockRef {
+	return BlockRef{Timestamp: timestamp}
+}
+
+func (ref BlockRef) _isZero() bool {
+	return ref.Number == nil && ref.Tag == "" && ref.Timestamp.IsZero()
+}
+
+// ResolveBlock turns ref into a block number argument. Tags resolve to the negative rpc.BlockNumber
+// values the node understands, so a tag is evaluated by the node at call time.
+func (ec *EvmClient) ResolveBlock(ctx context.Context, ref BlockRef) (*big.Int, error) {
+	switch {
+	case ref.Number != nil:
+		return ref.Number, nil
+	case ref.Tag != "":
+		var number rpc.BlockNumber
+		if err := number.UnmarshalJSON([]byte(`"` + ref.Tag + `"`)); err != nil {
+			return nil, fmt.Errorf("invalid block tag %q: %w", ref.Tag, err)
+		}
+		return big.NewInt(number.Int64()), nil
+	case !ref.Timestamp.IsZero():
+		number, err := ec.BlockNumberByTimestamp(ctx, ref.Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Int).SetUint64(number), nil
+	}
+	return nil, nil
+}
+
+// BlockNumberByTimestamp returns the last block mined at or before timestamp with a binary search over
+// the headers. Timestamps of finalized headers are cached, so repeated searches in finalized history
+// only fetch the latest and finalized headers.
+func (ec *EvmClient) BlockNumberByTimestamp(ctx context.Context, timestamp time.Time) (uint64, error) {
+	abiMethod := consts.EvmMethodBlockNumberByTimestamp
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	result, err := ec._blockNumberByTimestamp(ctx, timestamp)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return 0, err
+	}
+	return result, nil
+}
+
+func (ec *EvmClient) _blockNumberByTimestamp(ctx context.Context, timestamp time.Time) (uint64, error) {
+	target := uint64(timestamp.Unix())
+	latest, err := ec.ethClient.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	if latest.Time <= target {
+		return latest.Number.Uint64(), nil
+	}
+	// Blocks above the finalized one can be reorged, their timestamps are not cached. Nodes without the
+	// finalized tag only get the genesis block cached.
+	var finalized uint64
+	if header, err := ec.ethClient.HeaderByNumber(ctx, big.NewInt(int64(rpc.FinalizedBlockNumber))); err == nil {
+		finalized = header.Number.Uint64()
+	}
+	genesisTime, err := ec._blockTime(ctx, 0, finalized)
+	if err != nil {
+		return 0, err
+	}
+	if genesisTime > target {
+		return 0, ErrBlockBeforeGenesis
+	}
+	// Invariant: time(low) <= target < time(high).
+	low, high := uint64(0), latest.Number.Uint64()
+	for high-low > 1 {
+		mid := low + (high-low)/2
+		midTime, err := ec._blockTime(ctx, mid, finalized)
+		if err != nil {
+			return 0, err
+		}
+		if midTime <= target {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return low, nil
+}
+
+func (ec *EvmClient) _blockTime(ctx context.Context, number uint64, finalized uint64) (uint64, error) {
+	if cached, ok := ec._blockTimes._get(number); ok {
+		return cached, nil
+	}
+	header, err := ec.ethClient.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return 0, err
+	}
+	if number <= finalized {
+		ec._blockTimes._add(number, header.Time)
+	}
+	return header.Time, nil
+}
+
+// blockTimeCache maps block numbers to header timestamps, evicting the oldest entry once size is reached.
+type blockTimeCache struct {
+	mu    sync.Mutex
+	size  int
+	times map[uint64]uint64
+	order []uint64
+}
+
+func newBlockTimeCache(size int) *blockTimeCache {
+	return &blockTimeCache{size: size, times: make(map[uint64]uint64), order: make([]uint64, 0, size)}
+}
+
+func (c *blockTimeCache) _get(number uint64) (uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	timestamp, ok := c.times[number]
+	return timestamp, ok
+}
+func (c *blockTimeCache) _add(number uint64, timestamp uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.times[number]; ok {
+		return
+	}
+	if len(c.order) >= c.size {
+		delete(c.times, c.order[0])
+		c.order = c.order[1:]
+	}
+	c.times[number] = timestamp
+	c.order = append(c.order, number)
+}
@@ -0,0 +1,154 @@
+package client
+
+import (
+	"math/big"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/6boris/web3-go/erc/erc20"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testChainGenesisTime = 1700000000
+	testChainHead        = 1000
+	testChainFinalized   = 900
+)
+
+// testTimeChainService mines a block every 12 seconds, balances and token names equal the block number
+// they are read at.
+type testTimeChainService struct {
+	erc20Abi     abi.ABI
+	headerCalls  atomic.Int64
+	balanceBlock string
+}
+
+func (s *testTimeChainService) _number(block string) int64 {
+	switch block {
+	case "latest", "safe":
+		return testChainHead
+	case "finalized":
+		return testChainFinalized
+	}
+	number, err := hexutil.DecodeUint64(block)
+	if err != nil {
+		return -1
+	}
+	return int64(number)
+}
+
+func (s *testTimeChainService) GetBlockByNumber(block string, full bool) *types.Header {
+	_ = full
+	s.headerCalls.Add(1)
+	number := s._number(block)
+	if number < 0 || number > testChainHead {
+		return nil
+	}
+	return &types.Header{Number: big.NewInt(number), Time: uint64(testChainGenesisTime + 12*number), Difficulty: big.NewInt(0)}
+}
+
+func (s *testTimeChainService) GetBalance(account common.Address, block string) *hexutil.Big {
+	_ = account
+	s.balanceBlock = block
+	return (*hexutil.Big)(big.NewInt(s._number(block)))
+}
+
+func (s *testTimeChainService) Call(args map[string]interface{}, block string) (hexutil.Bytes, error) {
+	input, _ := args["input"].(string)
+	if input == hexutil.Encode(s.erc20Abi.Methods["name"].ID) {
+		return s.erc20Abi.Methods["name"].Outputs.Pack(strconv.FormatInt(s._number(block), 10))
+	}
+	return s.erc20Abi.Methods["balanceOf"].Outputs.Pack(big.NewInt(s._number(block)))
+}
+
+func TestEvmClient_Unite_BlockRef(t *testing.T) {
+	erc20Abi, err := erc20.ERC20MetaData.GetAbi()
+	assert.Nil(t, err)
+	service := &testTimeChainService{erc20Abi: *erc20Abi}
+	ec := _testEvmClient(t, map[string]interface{}{"eth": service}, nil)
+	account := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	token := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+
+	t.Run("BlockNumberByTimestamp", func(t *testing.T) {
+		cases := map[int64]uint64{
+			testChainGenesisTime:               0,
+			testChainGenesisTime + 11:          0,
+			testChainGenesisTime + 12*500:      500,
+			testChainGenesisTime + 12*500 + 5:  500,
+			testChainGenesisTime + 12*999 + 11: 999,
+			testChainGenesisTime + 12*5000:     testChainHead,
+		}
+		for timestamp, expected := range cases {
+			number, err := ec.BlockNumberByTimestamp(testCtx, time.Unix(timestamp, 0))
+			assert.Nil(t, err)
+			assert.Equal(t, expected, number, strconv.FormatInt(timestamp, 10))
+		}
+		_, err := ec.BlockNumberByTimestamp(testCtx, time.Unix(testChainGenesisTime-1, 0))
+		assert.ErrorIs(t, err, ErrBlockBeforeGenesis)
+
+		before := service.headerCalls.Load()
+		number, err := ec.BlockNumberByTimestamp(testCtx, time.Unix(testChainGenesisTime+12*500, 0))
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(500), number)
+		assert.Equal(t, int64(2), service.headerCalls.Load()-before)
+
+		before = service.headerCalls.Load()
+		number, err = ec.BlockNumberByTimestamp(testCtx, time.Unix(testChainGenesisTime+12*950, 0))
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(950), number)
+		assert.Greater(t, service.headerCalls.Load()-before, int64(2))
+	})
+	t.Run("BlockTimeCache", func(t *testing.T) {
+		cache := newBlockTimeCache(2)
+		cache._add(1, 10)
+		cache._add(2, 20)
+		cache._add(3, 30)
+		_, ok := cache._get(1)
+		assert.False(t, ok)
+		timestamp, ok := cache._get(3)
+		assert.True(t, ok)
+		assert.Equal(t, uint64(30), timestamp)
+	})
+	t.Run("ResolveBlock", func(t *testing.T) {
+		number, err := ec.ResolveBlock(testCtx, BlockAtTag("finalized"))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(rpc.FinalizedBlockNumber), number.Int64())
+		_, err = ec.ResolveBlock(testCtx, BlockAtTag("yesterday"))
+		assert.NotNil(t, err)
+		number, err = ec.ResolveBlock(testCtx, BlockRef{})
+		assert.Nil(t, err)
+		assert.Nil(t, number)
+	})
+	t.Run("WithBlock", func(t *testing.T) {
+		endOfDay := time.Unix(testChainGenesisTime+12*700+3, 0).UTC()
+		number, err := ec.ResolveBlock(testCtx, BlockAtTime(endOfDay))
+		assert.Nil(t, err)
+		balance, err := ec.BalanceAt(testCtx, account, number)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(700), balance.Int64())
+
+		tokenBalance, err := ec.ERC20BalanceOfWithBlock(testCtx, token, account, BlockAtTime(endOfDay))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(700), tokenBalance.Int64())
+		tokenBalance, err = ec.ERC20BalanceOfWithBlock(testCtx, token, account, BlockAtNumber(big.NewInt(42)))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(42), tokenBalance.Int64())
+		tokenBalance, err = ec.ERC20BalanceOf(testCtx, token, account)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(testChainHead), tokenBalance.Int64())
+
+		name, err := ec.ERC20NameWithBlock(testCtx, token, BlockAtNumber(big.NewInt(42)))
+		assert.Nil(t, err)
+		assert.Equal(t, "42", name)
+		name, err = ec.ERC20Name(testCtx, token)
+		assert.Nil(t, err)
+		assert.Equal(t, strconv.Itoa(testChainHead), name)
+	})
+}
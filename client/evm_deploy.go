@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"errors"
+
+	"github.com/6boris/web3-go/consts"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Create2DeployerProxy is the deterministic deployment proxy (https://github.com/Arachnid/deterministic-deployment-proxy),
+// it takes salt (32 bytes) ++ init code as calldata and deploys with CREATE2.
+var Create2DeployerProxy = common.HexToAddress("0x4e59b44847b379578588920cA78FbF26c0B4956C")
+
+var (
+	ErrCreate2DeployerNotFound = errors.New("create2 deployer proxy is not deployed on this chain")
+	ErrContractAlreadyDeployed = errors.New("contract already deployed at predicted address")
+)
+
+// DeployContract signs and sends a contract creation transaction, returning it with the address
+// the contract will have once mined.
+func (ec *EvmClient) DeployContract(ctx context.Context, signer common.Address, contractAbi abi.ABI, bytecode []byte, args ...interface{}) (*types.Transaction, common.Address, error) {
+	abiMethod := consts.EvmMethodDeployContract
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	initCode, err := ContractInitCode(contractAbi, bytecode, args...)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, common.Address{}, err
+	}
+	opts, err := ec._getTransactOptsWithMsg(ctx, signer, nil, nil, initCode)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, common.Address{}, err
+	}
+	address, tx, _, err := bind.DeployContract(opts, contractAbi, bytecode, ec._contractBackend(), args...)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, common.Address{}, err
+	}
+	return tx, address, nil
+}
+
+// DeployContractCreate2 deploys through Create2DeployerProxy so the address only depends on salt and init code.
+func (ec *EvmClient) DeployContractCreate2(ctx context.Context, signer common.Address, salt common.Hash, contractAbi abi.ABI, bytecode []byte, args ...interface{}) (*types.Transaction, common.Address, error) {
+	abiMethod := consts.EvmMethodDeployContractCreate2
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	initCode, err := ContractInitCode(contractAbi, bytecode, args...)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, common.Address{}, err
+	}
+	address := PredictCreate2Address(salt, initCode)
+	deployerCode, err := ec.ethClient.CodeAt(ctx, Create2DeployerProxy, nil)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, common.Address{}, err
+	}
+	if len(deployerCode) == 0 {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, common.Address{}, ErrCreate2DeployerNotFound
+	}
+	code, err := ec.ethClient.CodeAt(ctx, address, nil)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, common.Address{}, err
+	}
+	if len(code) > 0 {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, address, ErrContractAlreadyDeployed
+	}
+	data := append(salt.Bytes(), initCode...)
+	opts, err := ec._getTransactOptsWithMsg(ctx, signer, &Create2DeployerProxy, nil, data)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, common.Address{}, err
+	}
+	tx, err := bind.NewBoundContract(Create2DeployerProxy, abi.ABI{}, ec.ethClient, ec._contractBackend(), ec.ethClient).RawTransact(opts, data)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, common.Address{}, err
+	}
+	return tx, address, nil
+}
+
+// ContractInitCode returns bytecode followed by the ABI encoded constructor arguments.
+func ContractInitCode(contractAbi abi.ABI, bytecode []byte, args ...interface{}) ([]byte, error) {
+	input, err := contractAbi.Pack("", args...)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, bytecode...), input...), nil
+}
+
+// PredictCreateAddress returns the address of a contract created by deployer with nonce.
+func PredictCreateAddress(deployer common.Address, nonce uint64) common.Address {
+	return crypto.CreateAddress(deployer, nonce)
+}
+
+// PredictCreate2Address returns the address DeployContractCreate2 deploys initCode to.
+func PredictCreate2Address(salt common.Hash, initCode []byte) common.Address {
+	return crypto.CreateAddress2(Create2DeployerProxy, salt, crypto.Keccak256(initCode))
+}
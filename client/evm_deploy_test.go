@@ -0,0 +1,43 @@
+package client
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvmClient_Unite_Deploy(t *testing.T) {
+	contractAbi, err := abi.JSON(strings.NewReader(`[{"inputs":[{"internalType":"uint256","name":"initial","type":"uint256"}],"stateMutability":"nonpayable","type":"constructor"}]`))
+	assert.Nil(t, err)
+	bytecode := common.FromHex("0x6080604052348015600f57600080fd5b50")
+	t.Run("ContractInitCode", func(t *testing.T) {
+		initCode, err := ContractInitCode(contractAbi, bytecode, big.NewInt(42))
+		assert.Nil(t, err)
+		assert.Equal(t, len(bytecode)+32, len(initCode))
+		assert.Equal(t, bytecode, initCode[:len(bytecode)])
+		assert.Equal(t, int64(42), new(big.Int).SetBytes(initCode[len(bytecode):]).Int64())
+
+		_, err = ContractInitCode(contractAbi, bytecode)
+		assert.NotNil(t, err)
+	})
+	t.Run("PredictCreateAddress", func(t *testing.T) {
+		// https://ethereum.stackexchange.com/questions/760/how-is-the-address-of-an-ethereum-contract-computed
+		deployer := common.HexToAddress("0x6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0")
+		assert.Equal(t, common.HexToAddress("0xcd234a471b72ba2f1ccf0a70fcaba648a5eecd8d"), PredictCreateAddress(deployer, 0))
+		assert.Equal(t, common.HexToAddress("0x343c43a37d37dff08ae8c4a11544c718abb4fcf8"), PredictCreateAddress(deployer, 1))
+	})
+	t.Run("PredictCreate2Address", func(t *testing.T) {
+		salt := common.HexToHash("0x01")
+		initCode, err := ContractInitCode(contractAbi, bytecode, big.NewInt(42))
+		assert.Nil(t, err)
+		raw := append([]byte{0xff}, Create2DeployerProxy.Bytes()...)
+		raw = append(raw, salt.Bytes()...)
+		raw = append(raw, crypto.Keccak256(initCode)...)
+		assert.Equal(t, common.BytesToAddress(crypto.Keccak256(raw)[12:]), PredictCreate2Address(salt, initCode))
+	})
+}
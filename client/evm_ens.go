@@ -0,0 +1,347 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/6boris/web3-go/consts"
+	"github.com/6boris/web3-go/erc/ens"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// ENSRegistryAddress is the ENS registry, deployed at the same address on mainnet and the public testnets.
+var ENSRegistryAddress = common.HexToAddress("0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e")
+
+var (
+	ErrENSNameInvalid     = errors.New("ens name invalid")
+	ErrENSNameNotFound    = errors.New("ens name not found")
+	ErrENSReverseMismatch = errors.New("ens reverse record does not resolve back to the address")
+	ErrENSOffchainLookup  = errors.New("ens offchain lookup failed")
+)
+
+// ENSIP-10 extended resolver interface id, resolve(bytes,bytes).
+var interfaceIDExtendedResolver = [4]byte{0x90, 0x61, 0xb9, 0x23}
+
+// ensMaxOffchainRedirects bounds the number of OffchainLookup reverts followed for a single call (EIP-3668).
+const ensMaxOffchainRedirects = 4
+
+var ensHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+// ENSNormalize lowercases and trims name. Full UTS-46 normalisation is left to the caller.
+func ENSNormalize(name string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+}
+
+// ENSNameHash implements the namehash algorithm of EIP-137.
+func ENSNameHash(name string) common.Hash {
+	node := common.Hash{}
+	name = ENSNormalize(name)
+	if name == "" {
+		return node
+	}
+	labels := strings.Split(name, ".")
+	for i := len(labels) - 1; i >= 0; i-- {
+		node = crypto.Keccak256Hash(node.Bytes(), crypto.Keccak256([]byte(labels[i])))
+	}
+	return node
+}
+
+// ENSDNSEncode encodes name in DNS wire format as expected by ENSIP-10 resolve(bytes,bytes).
+func ENSDNSEncode(name string) ([]byte, error) {
+	name = ENSNormalize(name)
+	data := make([]byte, 0, len(name)+2)
+	if name != "" {
+		for _, label := range strings.Split(name, ".") {
+			if len(label) == 0 || len(label) > 63 {
+				return nil, ErrENSNameInvalid
+			}
+			data = append(data, byte(len(label)))
+			data = append(data, label...)
+		}
+	}
+	return append(data, 0), nil
+}
+
+// ResolveName returns the address name points to. Wildcard resolvers (ENSIP-10) and offchain
+// resolvers using CCIP-read (EIP-3668) are supported.
+func (ec *EvmClient) ResolveName(ctx context.Context, name string) (common.Address, error) {
+	return ec.ResolveNameWithBlock(ctx, name, BlockRef{})
+}
+func (ec *EvmClient) ResolveNameWithBlock(ctx context.Context, name string, block BlockRef) (common.Address, error) {
+	abiMethod := consts.EvmMethodENSResolveName
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	blockNumber, err := ec.ResolveBlock(ctx, block)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return common.Address{}, err
+	}
+	address, err := ec._ensResolveName(ctx, ENSNormalize(name), blockNumber)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return common.Address{}, err
+	}
+	return address, nil
+}
+
+// LookupAddress returns the primary name of address. The name is only returned when it resolves
+// back to address, otherwise ErrENSReverseMismatch.
+func (ec *EvmClient) LookupAddress(ctx context.Context, address common.Address) (string, error) {
+	return ec.LookupAddressWithBlock(ctx, address, BlockRef{})
+}
+func (ec *EvmClient) LookupAddressWithBlock(ctx context.Context, address common.Address, block BlockRef) (string, error) {
+	abiMethod := consts.EvmMethodENSLookupAddress
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	reverseName := strings.ToLower(address.Hex()[2:]) + ".addr.reverse"
+	node := ENSNameHash(reverseName)
+	registry, err := ens.NewENSRegistry(ENSRegistryAddress, ec.ethClient)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return "", err
+	}
+	blockNumber, err := ec.ResolveBlock(ctx, block)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return "", err
+	}
+	resolver, err := registry.Resolver(&bind.CallOpts{Context: ctx, BlockNumber: blockNumber}, node)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return "", err
+	}
+	if resolver == (common.Address{}) {
+		meta.Status = consts.AbiCallStatusFail
+		return "", ErrENSNameNotFound
+	}
+	resolverAbi, err := ens.ENSResolverMetaData.GetAbi()
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return "", err
+	}
+	abiData, err := resolverAbi.Pack("name", node)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return "", err
+	}
+	output, err := ec._ensCall(ctx, resolver, abiData, blockNumber)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return "", err
+	}
+	values, err := resolverAbi.Unpack("name", output)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return "", err
+	}
+	name := values[0].(string)
+	if name == "" {
+		meta.Status = consts.AbiCallStatusFail
+		return "", ErrENSNameNotFound
+	}
+	forward, err := ec._ensResolveName(ctx, ENSNormalize(name), blockNumber)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return "", err
+	}
+	if forward != address {
+		meta.Status = consts.AbiCallStatusFail
+		return "", ErrENSReverseMismatch
+	}
+	return name, nil
+}
+
+func (ec *EvmClient) _ensResolveName(ctx context.Context, name string, blockNumber *big.Int) (common.Address, error) {
+	if name == "" {
+		return common.Address{}, ErrENSNameInvalid
+	}
+	dnsName, err := ENSDNSEncode(name)
+	if err != nil {
+		return common.Address{}, err
+	}
+	node := ENSNameHash(name)
+	resolver, exact, err := ec._ensFindResolver(ctx, name, blockNumber)
+	if err != nil {
+		return common.Address{}, err
+	}
+	resolverAbi, err := ens.ENSResolverMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, err
+	}
+	addrData, err := resolverAbi.Pack("addr", node)
+	if err != nil {
+		return common.Address{}, err
+	}
+	inst, err := ens.NewENSResolver(resolver, ec.ethClient)
+	if err != nil {
+		return common.Address{}, err
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: blockNumber}
+	var output []byte
+	if extended, _ := inst.SupportsInterface(opts, interfaceIDExtendedResolver); extended {
+		resolveData, err := resolverAbi.Pack("resolve", dnsName, addrData)
+		if err != nil {
+			return common.Address{}, err
+		}
+		resolveOutput, err := ec._ensCall(ctx, resolver, resolveData, blockNumber)
+		if err != nil {
+			return common.Address{}, err
+		}
+		values, err := resolverAbi.Unpack("resolve", resolveOutput)
+		if err != nil {
+			return common.Address{}, err
+		}
+		output = values[0].([]byte)
+	} else {
+		if !exact {
+			return common.Address{}, ErrENSNameNotFound
+		}
+		output, err = ec._ensCall(ctx, resolver, addrData, blockNumber)
+		if err != nil {
+			return common.Address{}, err
+		}
+	}
+	values, err := resolverAbi.Unpack("addr", output)
+	if err != nil {
+		return common.Address{}, err
+	}
+	address := values[0].(common.Address)
+	if address == (common.Address{}) {
+		return common.Address{}, ErrENSNameNotFound
+	}
+	return address, nil
+}
+
+// _ensFindResolver walks up from name to the closest ancestor with a resolver, exact reports whether
+// it is set on name itself.
+func (ec *EvmClient) _ensFindResolver(ctx context.Context, name string, blockNumber *big.Int) (resolver common.Address, exact bool, err error) {
+	registry, err := ens.NewENSRegistry(ENSRegistryAddress, ec.ethClient)
+	if err != nil {
+		return common.Address{}, false, err
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: blockNumber}
+	labels := strings.Split(name, ".")
+	for i := range labels {
+		resolver, err = registry.Resolver(opts, ENSNameHash(strings.Join(labels[i:], ".")))
+		if err != nil {
+			return common.Address{}, false, err
+		}
+		if resolver != (common.Address{}) {
+			return resolver, i == 0, nil
+		}
+	}
+	return common.Address{}, false, ErrENSNameNotFound
+}
+
+// _ensCall is eth_call following OffchainLookup reverts (EIP-3668).
+func (ec *EvmClient) _ensCall(ctx context.Context, to common.Address, data []byte, blockNumber *big.Int) ([]byte, error) {
+	resolverAbi, err := ens.ENSResolverMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	lookupError := resolverAbi.Errors["OffchainLookup"]
+	bytesType, _ := abi.NewType("bytes", "", nil)
+	callbackArgs := abi.Arguments{{Type: bytesType}, {Type: bytesType}}
+	for i := 0; i <= ensMaxOffchainRedirects; i++ {
+		output, err := ec.ethClient.CallContract(ctx, ethereum.CallMsg{To: &to, Data: data}, blockNumber)
+		if err == nil {
+			return output, nil
+		}
+		var dataErr rpc.DataError
+		if !errors.As(err, &dataErr) {
+			return nil, err
+		}
+		errData, _ := dataErr.ErrorData().(string)
+		revert := common.FromHex(errData)
+		if len(revert) < 4 || !bytes.Equal(revert[:4], lookupError.ID[:4]) {
+			return nil, err
+		}
+		values, err := lookupError.Inputs.Unpack(revert[4:])
+		if err != nil {
+			return nil, err
+		}
+		sender := values[0].(common.Address)
+		urls := values[1].([]string)
+		callData := values[2].([]byte)
+		callback := values[3].([4]byte)
+		extraData := values[4].([]byte)
+		if sender != to {
+			return nil, fmt.Errorf("%w: sender %s is not %s", ErrENSOffchainLookup, sender.Hex(), to.Hex())
+		}
+		response, err := _ccipFetch(ctx, sender, urls, callData)
+		if err != nil {
+			return nil, err
+		}
+		callbackData, err := callbackArgs.Pack(response, extraData)
+		if err != nil {
+			return nil, err
+		}
+		data = append(callback[:], callbackData...)
+	}
+	return nil, fmt.Errorf("%w: too many redirects", ErrENSOffchainLookup)
+}
+
+// _ccipFetch queries the CCIP-read gateways in order. A 4xx answer is final, other failures move on to the next url.
+func _ccipFetch(ctx context.Context, sender common.Address, urls []string, callData []byte) ([]byte, error) {
+	senderHex := strings.ToLower(sender.Hex())
+	dataHex := hexutil.Encode(callData)
+	lastErr := fmt.Errorf("%w: no gateway urls", ErrENSOffchainLookup)
+	for _, url := range urls {
+		var request *http.Request
+		var err error
+		if strings.Contains(url, "{data}") {
+			url = strings.ReplaceAll(strings.ReplaceAll(url, "{sender}", senderHex), "{data}", dataHex)
+			request, err = http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		} else {
+			body, _ := json.Marshal(map[string]string{"data": dataHex, "sender": senderHex})
+			url = strings.ReplaceAll(url, "{sender}", senderHex)
+			request, err = http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+			if err == nil {
+				request.Header.Set("Content-Type", "application/json")
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		response, err := ensHTTPClient.Do(request)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		reply := struct {
+			Data    hexutil.Bytes `json:"data"`
+			Message string        `json:"message"`
+		}{}
+		err = json.NewDecoder(response.Body).Decode(&reply)
+		response.Body.Close()
+		if response.StatusCode >= 400 && response.StatusCode < 500 {
+			return nil, fmt.Errorf("%w: %s %d %s", ErrENSOffchainLookup, url, response.StatusCode, reply.Message)
+		}
+		if response.StatusCode != http.StatusOK || err != nil {
+			lastErr = fmt.Errorf("%w: %s %d", ErrENSOffchainLookup, url, response.StatusCode)
+			continue
+		}
+		return reply.Data, nil
+	}
+	return nil, lastErr
+}
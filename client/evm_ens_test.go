@@ -0,0 +1,172 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/6boris/web3-go/erc/ens"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+type testRevertError struct {
+	data []byte
+}
+
+func (e *testRevertError) Error() string          { return "execution reverted" }
+func (e *testRevertError) ErrorCode() int         { return 3 }
+func (e *testRevertError) ErrorData() interface{} { return hexutil.Encode(e.data) }
+
+// testENSService serves the registry, an onchain resolver and an offchain (CCIP-read) wildcard resolver.
+type testENSService struct {
+	registryAbi      abi.ABI
+	resolverAbi      abi.ABI
+	onchainResolver  common.Address
+	offchainResolver common.Address
+	gatewayURL       string
+	resolvers        map[common.Hash]common.Address
+	addresses        map[common.Hash]common.Address
+	names            map[common.Hash]string
+}
+
+func (s *testENSService) Call(args map[string]interface{}, block string) (hexutil.Bytes, error) {
+	_ = block
+	to := common.HexToAddress(args["to"].(string))
+	data := common.FromHex(args["input"].(string))
+	method, err := s.resolverAbi.MethodById(data)
+	if to == ENSRegistryAddress {
+		method, err = s.registryAbi.MethodById(data)
+	}
+	if err != nil {
+		if to == s.offchainResolver && bytes.Equal(data[:4], crypto.Keccak256([]byte("resolveWithProof(bytes,bytes)"))[:4]) {
+			// the gateway response is returned as is
+			values, err := abi.Arguments{{Type: mustType("bytes")}, {Type: mustType("bytes")}}.Unpack(data[4:])
+			if err != nil {
+				return nil, err
+			}
+			return abi.Arguments{{Type: mustType("bytes")}}.Pack(values[0])
+		}
+		return nil, err
+	}
+	inputs, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case to == ENSRegistryAddress && method.Name == "resolver":
+		return method.Outputs.Pack(s.resolvers[inputs[0].([32]byte)])
+	case method.Name == "supportsInterface":
+		return method.Outputs.Pack(to == s.offchainResolver && inputs[0].([4]byte) == interfaceIDExtendedResolver)
+	case to == s.onchainResolver && method.Name == "addr":
+		return method.Outputs.Pack(s.addresses[inputs[0].([32]byte)])
+	case to == s.onchainResolver && method.Name == "name":
+		return method.Outputs.Pack(s.names[inputs[0].([32]byte)])
+	case to == s.offchainResolver && method.Name == "resolve":
+		revert, err := s.resolverAbi.Errors["OffchainLookup"].Inputs.Pack(
+			s.offchainResolver, []string{s.gatewayURL + "/{sender}/{data}.json"}, inputs[1].([]byte),
+			[4]byte(crypto.Keccak256([]byte("resolveWithProof(bytes,bytes)"))[:4]), []byte("extra"))
+		if err != nil {
+			return nil, err
+		}
+		selector := s.resolverAbi.Errors["OffchainLookup"].ID
+		return nil, &testRevertError{data: append(selector[:4], revert...)}
+	}
+	return nil, errors.New("unsupported call")
+}
+
+func mustType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
+
+func TestEvmClient_Unite_ENS(t *testing.T) {
+	registryAbi, err := ens.ENSRegistryMetaData.GetAbi()
+	assert.Nil(t, err)
+	resolverAbi, err := ens.ENSResolverMetaData.GetAbi()
+	assert.Nil(t, err)
+	alice := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	bob := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	carol := common.HexToAddress("0x3000000000000000000000000000000000000003")
+	service := &testENSService{
+		registryAbi:      *registryAbi,
+		resolverAbi:      *resolverAbi,
+		onchainResolver:  common.HexToAddress("0x4976fb03C32e5B8cfe2b6cCB31c09Ba78EBaBa41"),
+		offchainResolver: common.HexToAddress("0xDB34Da70Cfd694190742E94B7f17769Bc3d84D27"),
+	}
+	reverse := func(address common.Address) common.Hash {
+		return ENSNameHash(strings.ToLower(address.Hex()[2:]) + ".addr.reverse")
+	}
+	service.resolvers = map[common.Hash]common.Address{
+		ENSNameHash("alice.eth"):    service.onchainResolver,
+		ENSNameHash("offchain.eth"): service.offchainResolver,
+		reverse(alice):              service.onchainResolver,
+		reverse(bob):                service.onchainResolver,
+	}
+	service.addresses = map[common.Hash]common.Address{ENSNameHash("alice.eth"): alice}
+	service.names = map[common.Hash]string{reverse(alice): "alice.eth", reverse(bob): "alice.eth"}
+
+	gateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(strings.TrimSuffix(r.URL.Path, ".json"), "/")
+		if len(parts) != 3 || common.HexToAddress(parts[1]) != service.offchainResolver {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		method, err := resolverAbi.MethodById(common.FromHex(parts[2]))
+		if err != nil || method.Name != "addr" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		output, _ := method.Outputs.Pack(carol)
+		_ = json.NewEncoder(w).Encode(map[string]string{"data": hexutil.Encode(output)})
+	}))
+	defer gateway.Close()
+	service.gatewayURL = gateway.URL
+
+	ec := _testEvmClient(t, map[string]interface{}{"eth": service}, nil)
+
+	t.Run("NameHash", func(t *testing.T) {
+		assert.Equal(t, common.Hash{}, ENSNameHash(""))
+		assert.Equal(t, "0x93cdeb708b7545dc668eb9280176169d1c33cfd8ed6f04690a0bcc88a93fc4ae", ENSNameHash("eth").Hex())
+		assert.Equal(t, "0xde9b09fd7c5f901e23a3f19fecc54828e9c848539801e86591bd9801b019f84f", ENSNameHash("Foo.ETH").Hex())
+		dnsName, err := ENSDNSEncode("vitalik.eth")
+		assert.Nil(t, err)
+		assert.Equal(t, "0x07766974616c696b0365746800", hexutil.Encode(dnsName))
+		_, err = ENSDNSEncode("a..eth")
+		assert.ErrorIs(t, err, ErrENSNameInvalid)
+	})
+	t.Run("ResolveName", func(t *testing.T) {
+		address, err := ec.ResolveName(testCtx, "Alice.eth")
+		assert.Nil(t, err)
+		assert.Equal(t, alice, address)
+		_, err = ec.ResolveName(testCtx, "nobody.eth")
+		assert.ErrorIs(t, err, ErrENSNameNotFound)
+		// wildcard resolver falls back to its parent only when it implements ENSIP-10
+		_, err = ec.ResolveName(testCtx, "sub.alice.eth")
+		assert.ErrorIs(t, err, ErrENSNameNotFound)
+	})
+	t.Run("ResolveNameOffchain", func(t *testing.T) {
+		address, err := ec.ResolveName(testCtx, "carol.offchain.eth")
+		assert.Nil(t, err)
+		assert.Equal(t, carol, address)
+	})
+	t.Run("LookupAddress", func(t *testing.T) {
+		name, err := ec.LookupAddress(testCtx, alice)
+		assert.Nil(t, err)
+		assert.Equal(t, "alice.eth", name)
+		_, err = ec.LookupAddress(testCtx, bob)
+		assert.ErrorIs(t, err, ErrENSReverseMismatch)
+		_, err = ec.LookupAddress(testCtx, carol)
+		assert.ErrorIs(t, err, ErrENSNameNotFound)
+	})
+}
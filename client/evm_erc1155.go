@@ -0,0 +1,251 @@
+package client
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/6boris/web3-go/consts"
+	"github.com/6boris/web3-go/erc/erc1155"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ERC1155TransferRecord is a single token movement, TransferBatch events are expanded into one record per id.
+type ERC1155TransferRecord struct {
+	Token       common.Address
+	Operator    common.Address
+	From        common.Address
+	To          common.Address
+	ID          *big.Int
+	Value       *big.Int
+	BlockNumber uint64
+	TxHash      common.Hash
+	LogIndex    uint
+}
+
+func (ec *EvmClient) ERC1155BalanceOf(ctx context.Context, token common.Address, account common.Address, id *big.Int) (*big.Int, error) {
+	return ec.ERC1155BalanceOfWithBlock(ctx, token, account, id, BlockRef{})
+}
+func (ec *EvmClient) ERC1155BalanceOfWithBlock(ctx context.Context, token common.Address, account common.Address, id *big.Int, block BlockRef) (*big.Int, error) {
+	abiMethod := consts.EvmErc1155MethodBalanceOf
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc1155.NewERC1155(token, ec.ethClient)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return big.NewInt(0), err
+	}
+	opts, err := ec._getCallOpts(ctx, block)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return big.NewInt(0), err
+	}
+	callResp, err := inst.BalanceOf(opts, account, id)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return big.NewInt(0), err
+	}
+	return callResp, nil
+}
+func (ec *EvmClient) ERC1155BalanceOfBatch(ctx context.Context, token common.Address, accounts []common.Address, ids []*big.Int) ([]*big.Int, error) {
+	return ec.ERC1155BalanceOfBatchWithBlock(ctx, token, accounts, ids, BlockRef{})
+}
+func (ec *EvmClient) ERC1155BalanceOfBatchWithBlock(ctx context.Context, token common.Address, accounts []common.Address, ids []*big.Int, block BlockRef) ([]*big.Int, error) {
+	abiMethod := consts.EvmErc1155MethodBalanceOfBatch
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc1155.NewERC1155(token, ec.ethClient)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	opts, err := ec._getCallOpts(ctx, block)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	callResp, err := inst.BalanceOfBatch(opts, accounts, ids)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return callResp, nil
+}
+
+// ERC1155URI returns the metadata uri of id, clients are expected to substitute the {id} placeholder themselves.
+func (ec *EvmClient) ERC1155URI(ctx context.Context, token common.Address, id *big.Int) (string, error) {
+	return ec.ERC1155URIWithBlock(ctx, token, id, BlockRef{})
+}
+func (ec *EvmClient) ERC1155URIWithBlock(ctx context.Context, token common.Address, id *big.Int, block BlockRef) (string, error) {
+	abiMethod := consts.EvmErc1155MethodURI
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc1155.NewERC1155(token, ec.ethClient)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return "", err
+	}
+	opts, err := ec._getCallOpts(ctx, block)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return "", err
+	}
+	callResp, err := inst.Uri(opts, id)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return "", err
+	}
+	return callResp, nil
+}
+func (ec *EvmClient) ERC1155SafeTransferFrom(ctx context.Context, token common.Address, signer common.Address, to common.Address, id *big.Int, value *big.Int, data []byte) (*types.Transaction, error) {
+	abiMethod := consts.EvmErc1155MethodSafeTransferFrom
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc1155.NewERC1155(token, ec._contractBackend())
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abi, err := erc1155.ERC1155MetaData.GetAbi()
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abiData, err := abi.Pack("safeTransferFrom", signer, to, id, value, data)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	opts, err := ec._getTransactOpts(ctx, signer, token, common.Bytes2Hex(abiData))
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	callResp, err := inst.SafeTransferFrom(opts, signer, to, id, value, data)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return callResp, nil
+}
+func (ec *EvmClient) ERC1155SafeBatchTransferFrom(ctx context.Context, token common.Address, signer common.Address, to common.Address, ids []*big.Int, values []*big.Int, data []byte) (*types.Transaction, error) {
+	abiMethod := consts.EvmErc1155MethodSafeBatchTransferFrom
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc1155.NewERC1155(token, ec._contractBackend())
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abi, err := erc1155.ERC1155MetaData.GetAbi()
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abiData, err := abi.Pack("safeBatchTransferFrom", signer, to, ids, values, data)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	opts, err := ec._getTransactOpts(ctx, signer, token, common.Bytes2Hex(abiData))
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	callResp, err := inst.SafeBatchTransferFrom(opts, signer, to, ids, values, data)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return callResp, nil
+}
+func (ec *EvmClient) ERC1155SetApprovalForAll(ctx context.Context, token common.Address, signer common.Address, operator common.Address, approved bool) (*types.Transaction, error) {
+	abiMethod := consts.EvmErc1155MethodSetApprovalForAll
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc1155.NewERC1155(token, ec._contractBackend())
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abi, err := erc1155.ERC1155MetaData.GetAbi()
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abiData, err := abi.Pack("setApprovalForAll", operator, approved)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	opts, err := ec._getTransactOpts(ctx, signer, token, common.Bytes2Hex(abiData))
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	callResp, err := inst.SetApprovalForAll(opts, operator, approved)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return callResp, nil
+}
+
+// ParseERC1155Transfers decodes TransferSingle and TransferBatch events in logs into per token records,
+// keeping log order and the id order inside a batch.
+func ParseERC1155Transfers(logs []*types.Log) []*ERC1155TransferRecord {
+	filterer, _ := erc1155.NewERC1155Filterer(common.Address{}, nil)
+	abi, _ := erc1155.ERC1155MetaData.GetAbi()
+	singleTopic := abi.Events["TransferSingle"].ID
+	batchTopic := abi.Events["TransferBatch"].ID
+	records := make([]*ERC1155TransferRecord, 0)
+	for _, v := range logs {
+		if len(v.Topics) != 4 {
+			continue
+		}
+		switch v.Topics[0] {
+		case singleTopic:
+			event, err := filterer.ParseTransferSingle(*v)
+			if err != nil {
+				continue
+			}
+			records = append(records, &ERC1155TransferRecord{
+				Token: v.Address, Operator: event.Operator, From: event.From, To: event.To,
+				ID: event.Id, Value: event.Value,
+				BlockNumber: v.BlockNumber, TxHash: v.TxHash, LogIndex: v.Index,
+			})
+		case batchTopic:
+			event, err := filterer.ParseTransferBatch(*v)
+			if err != nil || len(event.Ids) != len(event.Values) {
+				continue
+			}
+			for i := range event.Ids {
+				records = append(records, &ERC1155TransferRecord{
+					Token: v.Address, Operator: event.Operator, From: event.From, To: event.To,
+					ID: event.Ids[i], Value: event.Values[i],
+					BlockNumber: v.BlockNumber, TxHash: v.TxHash, LogIndex: v.Index,
+				})
+			}
+		}
+	}
+	return records
+}
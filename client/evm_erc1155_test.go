@@ -0,0 +1,50 @@
+package client
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/6boris/web3-go/erc/erc1155"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvmClient_Unite_ERC1155(t *testing.T) {
+	token := common.HexToAddress("0x76BE3b62873462d2142405439777e971754E8E77")
+	operator := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	from := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	to := common.HexToAddress("0x3000000000000000000000000000000000000003")
+	erc1155Abi, err := erc1155.ERC1155MetaData.GetAbi()
+	assert.Nil(t, err)
+	topics := func(event string) []common.Hash {
+		return []common.Hash{
+			erc1155Abi.Events[event].ID,
+			common.BytesToHash(operator.Bytes()), common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes()),
+		}
+	}
+	singleData, err := erc1155Abi.Events["TransferSingle"].Inputs.NonIndexed().Pack(big.NewInt(1), big.NewInt(10))
+	assert.Nil(t, err)
+	batchData, err := erc1155Abi.Events["TransferBatch"].Inputs.NonIndexed().Pack(
+		[]*big.Int{big.NewInt(2), big.NewInt(3)}, []*big.Int{big.NewInt(20), big.NewInt(30)})
+	assert.Nil(t, err)
+	logs := []*types.Log{
+		{Address: token, Topics: topics("TransferSingle"), Data: singleData, Index: 0},
+		{Address: token, Topics: topics("ApprovalForAll")[:3], Data: common.BigToHash(big.NewInt(1)).Bytes(), Index: 1},
+		{Address: token, Topics: topics("TransferBatch"), Data: batchData, Index: 2},
+	}
+
+	t.Run("ParseERC1155Transfers", func(t *testing.T) {
+		records := ParseERC1155Transfers(logs)
+		assert.Equal(t, 3, len(records))
+		for i, want := range [][3]int64{{1, 10, 0}, {2, 20, 2}, {3, 30, 2}} {
+			assert.Equal(t, token, records[i].Token)
+			assert.Equal(t, operator, records[i].Operator)
+			assert.Equal(t, from, records[i].From)
+			assert.Equal(t, to, records[i].To)
+			assert.Equal(t, want[0], records[i].ID.Int64())
+			assert.Equal(t, want[1], records[i].Value.Int64())
+			assert.Equal(t, uint(want[2]), records[i].LogIndex)
+		}
+	})
+}
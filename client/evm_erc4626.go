@@ -0,0 +1,385 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/6boris/web3-go/consts"
+	"github.com/6boris/web3-go/erc/erc4626"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var ErrApproveReverted = errors.New("approve transaction reverted")
+
+func (ec *EvmClient) ERC4626Asset(ctx context.Context, vault common.Address) (common.Address, error) {
+	return ec.ERC4626AssetWithBlock(ctx, vault, BlockRef{})
+}
+func (ec *EvmClient) ERC4626AssetWithBlock(ctx context.Context, vault common.Address, block BlockRef) (common.Address, error) {
+	abiMethod := consts.EvmErc4626MethodAsset
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc4626.NewERC4626(vault, ec.ethClient)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return common.Address{}, err
+	}
+	opts, err := ec._getCallOpts(ctx, block)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return common.Address{}, err
+	}
+	callResp, err := inst.Asset(opts)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return common.Address{}, err
+	}
+	return callResp, nil
+}
+func (ec *EvmClient) ERC4626TotalAssets(ctx context.Context, vault common.Address) (*big.Int, error) {
+	return ec.ERC4626TotalAssetsWithBlock(ctx, vault, BlockRef{})
+}
+func (ec *EvmClient) ERC4626TotalAssetsWithBlock(ctx context.Context, vault common.Address, block BlockRef) (*big.Int, error) {
+	abiMethod := consts.EvmErc4626MethodTotalAssets
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc4626.NewERC4626(vault, ec.ethClient)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return big.NewInt(0), err
+	}
+	opts, err := ec._getCallOpts(ctx, block)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return big.NewInt(0), err
+	}
+	callResp, err := inst.TotalAssets(opts)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return big.NewInt(0), err
+	}
+	return callResp, nil
+}
+func (ec *EvmClient) ERC4626ConvertToShares(ctx context.Context, vault common.Address, assets *big.Int) (*big.Int, error) {
+	return ec.ERC4626ConvertToSharesWithBlock(ctx, vault, assets, BlockRef{})
+}
+func (ec *EvmClient) ERC4626ConvertToSharesWithBlock(ctx context.Context, vault common.Address, assets *big.Int, block BlockRef) (*big.Int, error) {
+	abiMethod := consts.EvmErc4626MethodConvertToShares
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc4626.NewERC4626(vault, ec.ethClient)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return big.NewInt(0), err
+	}
+	opts, err := ec._getCallOpts(ctx, block)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return big.NewInt(0), err
+	}
+	callResp, err := inst.ConvertToShares(opts, assets)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return big.NewInt(0), err
+	}
+	return callResp, nil
+}
+func (ec *EvmClient) ERC4626ConvertToAssets(ctx context.Context, vault common.Address, shares *big.Int) (*big.Int, error) {
+	return ec.ERC4626ConvertToAssetsWithBlock(ctx, vault, shares, BlockRef{})
+}
+func (ec *EvmClient) ERC4626ConvertToAssetsWithBlock(ctx context.Context, vault common.Address, shares *big.Int, block BlockRef) (*big.Int, error) {
+	abiMethod := consts.EvmErc4626MethodConvertToAssets
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc4626.NewERC4626(vault, ec.ethClient)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return big.NewInt(0), err
+	}
+	opts, err := ec._getCallOpts(ctx, block)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return big.NewInt(0), err
+	}
+	callResp, err := inst.ConvertToAssets(opts, shares)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return big.NewInt(0), err
+	}
+	return callResp, nil
+}
+func (ec *EvmClient) ERC4626PreviewDeposit(ctx context.Context, vault common.Address, assets *big.Int) (*big.Int, error) {
+	return ec.ERC4626PreviewDepositWithBlock(ctx, vault, assets, BlockRef{})
+}
+func (ec *EvmClient) ERC4626PreviewDepositWithBlock(ctx context.Context, vault common.Address, assets *big.Int, block BlockRef) (*big.Int, error) {
+	abiMethod := consts.EvmErc4626MethodPreviewDeposit
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc4626.NewERC4626(vault, ec.ethClient)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return big.NewInt(0), err
+	}
+	opts, err := ec._getCallOpts(ctx, block)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return big.NewInt(0), err
+	}
+	callResp, err := inst.PreviewDeposit(opts, assets)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return big.NewInt(0), err
+	}
+	return callResp, nil
+}
+func (ec *EvmClient) ERC4626PreviewRedeem(ctx context.Context, vault common.Address, shares *big.Int) (*big.Int, error) {
+	return ec.ERC4626PreviewRedeemWithBlock(ctx, vault, shares, BlockRef{})
+}
+func (ec *EvmClient) ERC4626PreviewRedeemWithBlock(ctx context.Context, vault common.Address, shares *big.Int, block BlockRef) (*big.Int, error) {
+	abiMethod := consts.EvmErc4626MethodPreviewRedeem
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc4626.NewERC4626(vault, ec.ethClient)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return big.NewInt(0), err
+	}
+	opts, err := ec._getCallOpts(ctx, block)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return big.NewInt(0), err
+	}
+	callResp, err := inst.PreviewRedeem(opts, shares)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return big.NewInt(0), err
+	}
+	return callResp, nil
+}
+
+// ERC4626Deposit deposits assets of signer for receiver. When the vault allowance of signer is short the
+// asset is approved first and the call blocks until the approval is mined.
+func (ec *EvmClient) ERC4626Deposit(ctx context.Context, vault common.Address, signer common.Address, assets *big.Int, receiver common.Address) (*types.Transaction, error) {
+	abiMethod := consts.EvmErc4626MethodDeposit
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc4626.NewERC4626(vault, ec._contractBackend())
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	callOpts, err := ec._getCallOpts(ctx, BlockRef{})
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	asset, err := inst.Asset(callOpts)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	if err = ec._ensureAllowance(ctx, asset, signer, vault, assets); err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abi, err := erc4626.ERC4626MetaData.GetAbi()
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abiData, err := abi.Pack("deposit", assets, receiver)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	opts, err := ec._getTransactOpts(ctx, signer, vault, common.Bytes2Hex(abiData))
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	callResp, err := inst.Deposit(opts, assets, receiver)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return callResp, nil
+}
+
+// ERC4626Mint mints exactly shares for receiver, approving the previewMint amount of assets like ERC4626Deposit.
+func (ec *EvmClient) ERC4626Mint(ctx context.Context, vault common.Address, signer common.Address, shares *big.Int, receiver common.Address) (*types.Transaction, error) {
+	abiMethod := consts.EvmErc4626MethodMint
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc4626.NewERC4626(vault, ec._contractBackend())
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	callOpts, err := ec._getCallOpts(ctx, BlockRef{})
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	asset, err := inst.Asset(callOpts)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	assets, err := inst.PreviewMint(callOpts, shares)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	if err = ec._ensureAllowance(ctx, asset, signer, vault, assets); err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abi, err := erc4626.ERC4626MetaData.GetAbi()
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abiData, err := abi.Pack("mint", shares, receiver)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	opts, err := ec._getTransactOpts(ctx, signer, vault, common.Bytes2Hex(abiData))
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	callResp, err := inst.Mint(opts, shares, receiver)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return callResp, nil
+}
+func (ec *EvmClient) ERC4626Withdraw(ctx context.Context, vault common.Address, signer common.Address, assets *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	abiMethod := consts.EvmErc4626MethodWithdraw
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc4626.NewERC4626(vault, ec._contractBackend())
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abi, err := erc4626.ERC4626MetaData.GetAbi()
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abiData, err := abi.Pack("withdraw", assets, receiver, owner)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	opts, err := ec._getTransactOpts(ctx, signer, vault, common.Bytes2Hex(abiData))
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	callResp, err := inst.Withdraw(opts, assets, receiver, owner)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return callResp, nil
+}
+func (ec *EvmClient) ERC4626Redeem(ctx context.Context, vault common.Address, signer common.Address, shares *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	abiMethod := consts.EvmErc4626MethodRedeem
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc4626.NewERC4626(vault, ec._contractBackend())
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abi, err := erc4626.ERC4626MetaData.GetAbi()
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abiData, err := abi.Pack("redeem", shares, receiver, owner)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	opts, err := ec._getTransactOpts(ctx, signer, vault, common.Bytes2Hex(abiData))
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	callResp, err := inst.Redeem(opts, shares, receiver, owner)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return callResp, nil
+}
+
+// _ensureAllowance approves spender for amount of token when the current allowance of owner is lower and waits for the receipt.
+// A nonzero allowance is reset to zero first, tokens such as USDT revert when changing it directly.
+func (ec *EvmClient) _ensureAllowance(ctx context.Context, token common.Address, owner common.Address, spender common.Address, amount *big.Int) error {
+	allowance, err := ec.ERC20Allowance(ctx, token, owner, spender)
+	if err != nil {
+		return err
+	}
+	if allowance.Cmp(amount) >= 0 {
+		return nil
+	}
+	if allowance.Sign() > 0 {
+		if err = ec._approveAndWait(ctx, token, owner, spender, big.NewInt(0)); err != nil {
+			return err
+		}
+	}
+	return ec._approveAndWait(ctx, token, owner, spender, amount)
+}
+func (ec *EvmClient) _approveAndWait(ctx context.Context, token common.Address, owner common.Address, spender common.Address, amount *big.Int) error {
+	tx, err := ec.ERC20Approve(ctx, token, owner, spender, amount)
+	if err != nil {
+		return err
+	}
+	receipt, err := bind.WaitMined(ctx, ec.ethClient, tx)
+	if err != nil {
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return ErrApproveReverted
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package client
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/6boris/web3-go/erc/erc20"
+	"github.com/6boris/web3-go/erc/erc4626"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/6boris/web3-go/pkg/pk"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+// testVaultService answers eth_call for a vault holding 2 assets per share, approvals sent to the asset
+// are mined at once and replace the allowance.
+type testVaultService struct {
+	testTxService
+	asset     common.Address
+	allowance *big.Int
+	methods   map[string]abi.Method
+	estimated [][]byte
+	approvals []*big.Int
+}
+
+func (s *testVaultService) GetTransactionCount(address common.Address, block string) hexutil.Uint64 {
+	_, _ = address, block
+	return hexutil.Uint64(len(s.approvals))
+}
+func (s *testVaultService) EstimateGas(args map[string]interface{}, block *string) hexutil.Uint64 {
+	_ = block
+	input, _ := args["input"].(string)
+	s.estimated = append(s.estimated, common.FromHex(input))
+	return 46000
+}
+func (s *testVaultService) SendRawTransaction(input hexutil.Bytes) (common.Hash, error) {
+	hash, err := s.testTxService.SendRawTransaction(input)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	inputs, err := s.methods["approve"].Inputs.Unpack(s._lastSent().Data()[4:])
+	if err != nil {
+		return common.Hash{}, err
+	}
+	s.allowance = inputs[1].(*big.Int)
+	s.approvals = append(s.approvals, s.allowance)
+	return hash, nil
+}
+func (s *testVaultService) GetTransactionReceipt(hash common.Hash) *types.Receipt {
+	return &types.Receipt{Status: types.ReceiptStatusSuccessful, TxHash: hash, Logs: []*types.Log{}, BlockNumber: big.NewInt(1)}
+}
+
+func (s *testVaultService) Call(args map[string]interface{}, block string) (hexutil.Bytes, error) {
+	_ = block
+	input, _ := args["input"].(string)
+	data := common.FromHex(input)
+	if len(data) < 4 {
+		return nil, errors.New("empty call")
+	}
+	for _, method := range s.methods {
+		if string(method.ID) != string(data[:4]) {
+			continue
+		}
+		inputs, err := method.Inputs.Unpack(data[4:])
+		if err != nil {
+			return nil, err
+		}
+		switch method.Name {
+		case "asset":
+			return method.Outputs.Pack(s.asset)
+		case "totalAssets":
+			return method.Outputs.Pack(big.NewInt(2000))
+		case "convertToShares", "previewDeposit":
+			return method.Outputs.Pack(new(big.Int).Div(inputs[0].(*big.Int), big.NewInt(2)))
+		case "convertToAssets", "previewRedeem", "previewMint":
+			return method.Outputs.Pack(new(big.Int).Mul(inputs[0].(*big.Int), big.NewInt(2)))
+		case "allowance":
+			return method.Outputs.Pack(s.allowance)
+		}
+	}
+	return nil, errors.New("unsupported call")
+}
+
+func TestEvmClient_Unite_ERC4626(t *testing.T) {
+	vaultAbi, err := erc4626.ERC4626MetaData.GetAbi()
+	assert.Nil(t, err)
+	erc20Abi, err := erc20.ERC20MetaData.GetAbi()
+	assert.Nil(t, err)
+	service := &testVaultService{
+		asset:     common.HexToAddress("0x6B175474E89094C44Da98b954EedeAC495271d0F"),
+		allowance: big.NewInt(100),
+		methods:   map[string]abi.Method{"allowance": erc20Abi.Methods["allowance"], "approve": erc20Abi.Methods["approve"]},
+	}
+	for k, v := range vaultAbi.Methods {
+		service.methods[k] = v
+	}
+	signer, err := pk.TransformPkToEvmSigner(hex.EncodeToString(crypto.Keccak256([]byte("vault"))))
+	assert.Nil(t, err)
+	ec := _testEvmClient(t, map[string]interface{}{"eth": service}, &clientModel.ConfEvmChainClient{
+		Signers: []*clientModel.ConfEvmChainSigner{signer},
+	})
+	vault := common.HexToAddress("0x83F20F44975D03b1b09e64809B757c47f942BEeA")
+	owner := signer.PublicAddress
+
+	t.Run("Read", func(t *testing.T) {
+		asset, err := ec.ERC4626Asset(testCtx, vault)
+		assert.Nil(t, err)
+		assert.Equal(t, service.asset, asset)
+		totalAssets, err := ec.ERC4626TotalAssets(testCtx, vault)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2000), totalAssets.Int64())
+		shares, err := ec.ERC4626ConvertToShares(testCtx, vault, big.NewInt(10))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(5), shares.Int64())
+		assets, err := ec.ERC4626ConvertToAssets(testCtx, vault, big.NewInt(10))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(20), assets.Int64())
+		shares, err = ec.ERC4626PreviewDeposit(testCtx, vault, big.NewInt(10))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(5), shares.Int64())
+		assets, err = ec.ERC4626PreviewRedeem(testCtx, vault, big.NewInt(10))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(20), assets.Int64())
+	})
+	t.Run("EnsureAllowance", func(t *testing.T) {
+		assert.Nil(t, ec._ensureAllowance(testCtx, service.asset, owner, vault, big.NewInt(100)))
+		assert.Empty(t, service.approvals)
+		// the nonzero allowance is reset first
+		assert.Nil(t, ec._ensureAllowance(testCtx, service.asset, owner, vault, big.NewInt(101)))
+		assert.Equal(t, 2, len(service.approvals))
+		assert.Equal(t, int64(0), service.approvals[0].Int64())
+		assert.Equal(t, int64(101), service.approvals[1].Int64())
+		for _, data := range service.estimated {
+			assert.Equal(t, erc20Abi.Methods["approve"].ID, data[:4])
+		}
+		service.allowance = big.NewInt(0)
+		assert.Nil(t, ec._ensureAllowance(testCtx, service.asset, owner, vault, big.NewInt(5)))
+		assert.Equal(t, 3, len(service.approvals))
+	})
+}
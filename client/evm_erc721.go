@@ -0,0 +1,264 @@
+package client
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/6boris/web3-go/consts"
+	"github.com/6boris/web3-go/erc/erc721"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ERC-165 interface ids, see https://eips.ethereum.org/EIPS/eip-721
+var (
+	InterfaceIDERC165           = [4]byte{0x01, 0xff, 0xc9, 0xa7}
+	InterfaceIDERC721           = [4]byte{0x80, 0xac, 0x58, 0xcd}
+	InterfaceIDERC721Metadata   = [4]byte{0x5b, 0x5e, 0x13, 0x9f}
+	InterfaceIDERC721Enumerable = [4]byte{0x78, 0x0e, 0x9d, 0x63}
+)
+
+func (ec *EvmClient) ERC721OwnerOf(ctx context.Context, token common.Address, tokenID *big.Int) (common.Address, error) {
+	return ec.ERC721OwnerOfWithBlock(ctx, token, tokenID, BlockRef{})
+}
+func (ec *EvmClient) ERC721OwnerOfWithBlock(ctx context.Context, token common.Address, tokenID *big.Int, block BlockRef) (common.Address, error) {
+	abiMethod := consts.EvmErc721MethodOwnerOf
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc721.NewERC721(token, ec.ethClient)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return common.Address{}, err
+	}
+	opts, err := ec._getCallOpts(ctx, block)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return common.Address{}, err
+	}
+	callResp, err := inst.OwnerOf(opts, tokenID)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return common.Address{}, err
+	}
+	return callResp, nil
+}
+func (ec *EvmClient) ERC721BalanceOf(ctx context.Context, token common.Address, owner common.Address) (*big.Int, error) {
+	return ec.ERC721BalanceOfWithBlock(ctx, token, owner, BlockRef{})
+}
+func (ec *EvmClient) ERC721BalanceOfWithBlock(ctx context.Context, token common.Address, owner common.Address, block BlockRef) (*big.Int, error) {
+	abiMethod := consts.EvmErc721MethodBalanceOf
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc721.NewERC721(token, ec.ethClient)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return big.NewInt(0), err
+	}
+	opts, err := ec._getCallOpts(ctx, block)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return big.NewInt(0), err
+	}
+	callResp, err := inst.BalanceOf(opts, owner)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return big.NewInt(0), err
+	}
+	return callResp, nil
+}
+func (ec *EvmClient) ERC721TokenURI(ctx context.Context, token common.Address, tokenID *big.Int) (string, error) {
+	return ec.ERC721TokenURIWithBlock(ctx, token, tokenID, BlockRef{})
+}
+func (ec *EvmClient) ERC721TokenURIWithBlock(ctx context.Context, token common.Address, tokenID *big.Int, block BlockRef) (string, error) {
+	abiMethod := consts.EvmErc721MethodTokenURI
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc721.NewERC721(token, ec.ethClient)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return "", err
+	}
+	opts, err := ec._getCallOpts(ctx, block)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return "", err
+	}
+	callResp, err := inst.TokenURI(opts, tokenID)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return "", err
+	}
+	return callResp, nil
+}
+func (ec *EvmClient) ERC721SupportsInterface(ctx context.Context, token common.Address, interfaceID [4]byte) (bool, error) {
+	return ec.ERC721SupportsInterfaceWithBlock(ctx, token, interfaceID, BlockRef{})
+}
+func (ec *EvmClient) ERC721SupportsInterfaceWithBlock(ctx context.Context, token common.Address, interfaceID [4]byte, block BlockRef) (bool, error) {
+	abiMethod := consts.EvmErc721MethodSupportsInterface
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc721.NewERC721(token, ec.ethClient)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return false, err
+	}
+	opts, err := ec._getCallOpts(ctx, block)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return false, err
+	}
+	callResp, err := inst.SupportsInterface(opts, interfaceID)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return false, err
+	}
+	return callResp, nil
+}
+func (ec *EvmClient) ERC721SafeTransferFrom(ctx context.Context, token common.Address, signer common.Address, to common.Address, tokenID *big.Int) (*types.Transaction, error) {
+	abiMethod := consts.EvmErc721MethodSafeTransferFrom
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc721.NewERC721(token, ec._contractBackend())
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abi, err := erc721.ERC721MetaData.GetAbi()
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abiData, err := abi.Pack("safeTransferFrom", signer, to, tokenID)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	opts, err := ec._getTransactOpts(ctx, signer, token, common.Bytes2Hex(abiData))
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	callResp, err := inst.SafeTransferFrom(opts, signer, to, tokenID)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return callResp, nil
+}
+func (ec *EvmClient) ERC721Approve(ctx context.Context, token common.Address, signer common.Address, to common.Address, tokenID *big.Int) (*types.Transaction, error) {
+	abiMethod := consts.EvmErc721MethodApprove
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc721.NewERC721(token, ec._contractBackend())
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abi, err := erc721.ERC721MetaData.GetAbi()
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abiData, err := abi.Pack("approve", to, tokenID)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	opts, err := ec._getTransactOpts(ctx, signer, token, common.Bytes2Hex(abiData))
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	callResp, err := inst.Approve(opts, to, tokenID)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return callResp, nil
+}
+func (ec *EvmClient) ERC721SetApprovalForAll(ctx context.Context, token common.Address, signer common.Address, operator common.Address, approved bool) (*types.Transaction, error) {
+	abiMethod := consts.EvmErc721MethodSetApprovalForAll
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc721.NewERC721(token, ec._contractBackend())
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abi, err := erc721.ERC721MetaData.GetAbi()
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abiData, err := abi.Pack("setApprovalForAll", operator, approved)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	opts, err := ec._getTransactOpts(ctx, signer, token, common.Bytes2Hex(abiData))
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	callResp, err := inst.SetApprovalForAll(opts, operator, approved)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return callResp, nil
+}
+
+// ParseERC721Transfers decodes the ERC-721 Transfer events in logs, skipping any other log including
+// ERC-20 Transfer events which share the topic but index one field less.
+func ParseERC721Transfers(logs []*types.Log) []*erc721.ERC721Transfer {
+	filterer, _ := erc721.NewERC721Filterer(common.Address{}, nil)
+	events := make([]*erc721.ERC721Transfer, 0)
+	for _, v := range logs {
+		if len(v.Topics) != 4 {
+			continue
+		}
+		event, err := filterer.ParseTransfer(*v)
+		if err == nil {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// ParseERC721Approvals decodes the ERC-721 Approval events in logs.
+func ParseERC721Approvals(logs []*types.Log) []*erc721.ERC721Approval {
+	filterer, _ := erc721.NewERC721Filterer(common.Address{}, nil)
+	events := make([]*erc721.ERC721Approval, 0)
+	for _, v := range logs {
+		if len(v.Topics) != 4 {
+			continue
+		}
+		event, err := filterer.ParseApproval(*v)
+		if err == nil {
+			events = append(events, event)
+		}
+	}
+	return events
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvmClient_Unite_ERC721(t *testing.T) {
+	token := common.HexToAddress("0xBC4CA0EdA7647A8aB7C2061c2E118A18a936f13D")
+	from := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	to := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	transferTopic := crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	approvalTopic := crypto.Keccak256Hash([]byte("Approval(address,address,uint256)"))
+	logs := []*types.Log{
+		{
+			Address: token,
+			Topics:  []common.Hash{transferTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes()), common.BigToHash(big.NewInt(7))},
+		},
+		{
+			// ERC-20 Transfer, value in data
+			Address: token,
+			Topics:  []common.Hash{transferTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+			Data:    common.BigToHash(big.NewInt(100)).Bytes(),
+		},
+		{
+			Address: token,
+			Topics:  []common.Hash{approvalTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes()), common.BigToHash(big.NewInt(8))},
+		},
+	}
+	t.Run("ParseERC721Transfers", func(t *testing.T) {
+		events := ParseERC721Transfers(logs)
+		assert.Equal(t, 1, len(events))
+		assert.Equal(t, from, events[0].From)
+		assert.Equal(t, to, events[0].To)
+		assert.Equal(t, int64(7), events[0].TokenId.Int64())
+		assert.Equal(t, token, events[0].Raw.Address)
+	})
+	t.Run("ParseERC721Approvals", func(t *testing.T) {
+		events := ParseERC721Approvals(logs)
+		assert.Equal(t, 1, len(events))
+		assert.Equal(t, from, events[0].Owner)
+		assert.Equal(t, to, events[0].Approved)
+		assert.Equal(t, int64(8), events[0].TokenId.Int64())
+	})
+}
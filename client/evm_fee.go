@@ -0,0 +1,210 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/6boris/web3-go/consts"
+	"github.com/6boris/web3-go/erc/l2"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/shopspring/decimal"
+)
+
+// OptimismGasPriceOracleAddress is the GasPriceOracle predeploy of OP Stack chains.
+var OptimismGasPriceOracleAddress = common.HexToAddress("0x420000000000000000000000000000000000000F")
+
+// ArbitrumNodeInterfaceAddress is the virtual NodeInterface of Arbitrum chains, only reachable with eth_call.
+var ArbitrumNodeInterfaceAddress = common.HexToAddress("0x00000000000000000000000000000000000000C8")
+
+// chainFamilies is the family of the known L2 chains when the client configures none, other chains are
+// priced as Ethereum.
+var chainFamilies = map[int64]string{
+	10:       consts.ChainFamilyOptimism,
+	420:      consts.ChainFamilyOptimism,
+	8453:     consts.ChainFamilyOptimism,
+	84532:    consts.ChainFamilyOptimism,
+	11155420: consts.ChainFamilyOptimism,
+	42161:    consts.ChainFamilyArbitrum,
+	42170:    consts.ChainFamilyArbitrum,
+	421613:   consts.ChainFamilyArbitrum,
+	421614:   consts.ChainFamilyArbitrum,
+}
+
+// FeeEstimate is the expected cost of a transaction, Total = L2Fee + L1Fee. On L1 chains L1Fee is zero.
+type FeeEstimate struct {
+	ChainFamily string
+	// GasLimit is the estimated gas of the transaction, on Arbitrum it includes L1GasLimit.
+	GasLimit   uint64
+	L1GasLimit uint64
+	GasPrice   *big.Int
+	L2Fee      *big.Int
+	L1Fee      *big.Int
+	Total      *big.Int
+}
+
+// EstimateFee estimates the cost of msg according to the chain family of the client. OP Stack chains
+// charge the L1 data fee reported by the GasPriceOracle on top of the execution fee, Arbitrum folds
+// its L1 component into the gas estimate which NodeInterface splits out. The gas price is the one
+// used by the send methods, the suggested price times GasFeeRate, unless msg sets one.
+func (ec *EvmClient) EstimateFee(ctx context.Context, msg ethereum.CallMsg) (*FeeEstimate, error) {
+	abiMethod := consts.EvmMethodEstimateFee
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	result, err := ec._estimateFee(ctx, msg)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return result, nil
+}
+
+func (ec *EvmClient) _estimateFee(ctx context.Context, msg ethereum.CallMsg) (*FeeEstimate, error) {
+	chainFamily, err := ec._getChainFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := &FeeEstimate{ChainFamily: chainFamily, GasPrice: msg.GasPrice, L1Fee: big.NewInt(0)}
+	if result.GasPrice == nil {
+		gasPrice, err := ec.ethClient.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result.GasPrice = decimal.NewFromBigInt(gasPrice, 0).Mul(ec._gasFeeRate).BigInt()
+	}
+	// The price only prices the estimate, sent to the node it makes the call require a balance covering
+	// gas times price up front.
+	msg.GasPrice = nil
+	switch result.ChainFamily {
+	case consts.ChainFamilyArbitrum:
+		if err := ec._estimateFeeArbitrum(ctx, msg, result); err != nil {
+			return nil, err
+		}
+	default:
+		gas, err := ec.ethClient.EstimateGas(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+		result.GasLimit = gas
+		if result.ChainFamily == consts.ChainFamilyOptimism {
+			if result.L1Fee, err = ec._estimateL1FeeOptimism(ctx, msg, result); err != nil {
+				return nil, err
+			}
+		}
+	}
+	l2Gas := new(big.Int).SetUint64(result.GasLimit - result.L1GasLimit)
+	result.L2Fee = new(big.Int).Mul(l2Gas, result.GasPrice)
+	result.Total = new(big.Int).Add(result.L2Fee, result.L1Fee)
+	return result, nil
+}
+
+// _getChainFamily returns the configured chain family, or the family of the chain ID of the node.
+func (ec *EvmClient) _getChainFamily(ctx context.Context) (string, error) {
+	if ec._chainFamily != "" {
+		return ec._chainFamily, nil
+	}
+	chainID := ec._ethChainID
+	if chainID == 0 {
+		id, err := ec.ethClient.ChainID(ctx)
+		if err != nil {
+			return "", err
+		}
+		chainID = id.Int64()
+	}
+	if family, ok := chainFamilies[chainID]; ok {
+		return family, nil
+	}
+	return consts.ChainFamilyEthereum, nil
+}
+
+// _checkL1Fee fails early when the balance of the sender cannot cover the L1 data fee that OP Stack
+// chains charge on top of gas limit times gas price, the node would accept the transaction otherwise.
+func (ec *EvmClient) _checkL1Fee(ctx context.Context, opts *bind.TransactOpts, estimate *FeeEstimate) error {
+	if estimate.L1Fee.Sign() == 0 || estimate.ChainFamily != consts.ChainFamilyOptimism {
+		return nil
+	}
+	gasLimit := opts.GasLimit
+	if gasLimit == 0 {
+		gasLimit = estimate.GasLimit
+	}
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(gasLimit), opts.GasPrice)
+	cost.Add(cost, estimate.L1Fee)
+	if opts.Value != nil {
+		cost.Add(cost, opts.Value)
+	}
+	balance, err := ec.ethClient.PendingBalanceAt(ctx, opts.From)
+	if err != nil {
+		return err
+	}
+	if balance.Cmp(cost) < 0 {
+		return fmt.Errorf("insufficient funds for gas * price + value + l1 fee: address %s have %s want %s", opts.From, balance, cost)
+	}
+	return nil
+}
+
+// _estimateL1FeeOptimism prices the unsigned transaction with GasPriceOracle.getL1Fee, which accounts
+// for the signature itself.
+func (ec *EvmClient) _estimateL1FeeOptimism(ctx context.Context, msg ethereum.CallMsg, estimate *FeeEstimate) (*big.Int, error) {
+	nonce, err := ec.ethClient.PendingNonceAt(ctx, msg.From)
+	if err != nil {
+		return nil, err
+	}
+	txData, err := types.NewTx(&types.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: estimate.GasPrice,
+		Gas:      estimate.GasLimit,
+		To:       msg.To,
+		Value:    msg.Value,
+		Data:     msg.Data,
+	}).MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	oracle, err := l2.NewGasPriceOracleCaller(OptimismGasPriceOracleAddress, ec.ethClient)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := ec._getCallOpts(ctx, BlockRef{})
+	if err != nil {
+		return nil, err
+	}
+	return oracle.GetL1Fee(opts, txData)
+}
+
+func (ec *EvmClient) _estimateFeeArbitrum(ctx context.Context, msg ethereum.CallMsg, estimate *FeeEstimate) error {
+	nodeAbi, err := l2.NodeInterfaceMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	to := common.Address{}
+	if msg.To != nil {
+		to = *msg.To
+	}
+	abiData, err := nodeAbi.Pack("gasEstimateComponents", to, msg.To == nil, msg.Data)
+	if err != nil {
+		return err
+	}
+	call := ethereum.CallMsg{From: msg.From, To: &ArbitrumNodeInterfaceAddress, Value: msg.Value, Data: abiData}
+	output, err := ec.ethClient.CallContract(ctx, call, nil)
+	if err != nil {
+		return err
+	}
+	values, err := nodeAbi.Unpack("gasEstimateComponents", output)
+	if err != nil {
+		return err
+	}
+	estimate.GasLimit = values[0].(uint64)
+	estimate.L1GasLimit = values[1].(uint64)
+	if estimate.L1GasLimit > estimate.GasLimit {
+		estimate.L1GasLimit = estimate.GasLimit
+	}
+	estimate.L1Fee = new(big.Int).Mul(new(big.Int).SetUint64(estimate.L1GasLimit), estimate.GasPrice)
+	return nil
+}
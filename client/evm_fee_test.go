@@ -0,0 +1,130 @@
+package client
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/6boris/web3-go/consts"
+	"github.com/6boris/web3-go/erc/l2"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/6boris/web3-go/pkg/pk"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+type testFeeService struct {
+	testTxService
+	oracleAbi abi.ABI
+	nodeAbi   abi.ABI
+	balance   *big.Int
+}
+
+func (s *testFeeService) GetBalance(address common.Address, block string) *hexutil.Big {
+	_, _ = address, block
+	return (*hexutil.Big)(s.balance)
+}
+
+func (s *testFeeService) Call(args map[string]interface{}, block string) (hexutil.Bytes, error) {
+	_ = block
+	data := common.FromHex(args["input"].(string))
+	switch common.HexToAddress(args["to"].(string)) {
+	case OptimismGasPriceOracleAddress:
+		inputs, err := s.oracleAbi.Methods["getL1Fee"].Inputs.Unpack(data[4:])
+		if err != nil {
+			return nil, err
+		}
+		tx := new(types.Transaction)
+		if err = tx.UnmarshalBinary(inputs[0].([]byte)); err != nil {
+			return nil, err
+		}
+		if tx.Nonce() != 5 || tx.Gas() != 21000 {
+			return nil, errors.New("unexpected transaction")
+		}
+		return s.oracleAbi.Methods["getL1Fee"].Outputs.Pack(big.NewInt(5e12))
+	case ArbitrumNodeInterfaceAddress:
+		if args["gas"] != nil || args["gasPrice"] != nil {
+			return nil, errors.New("insufficient funds for gas * price + value")
+		}
+		return s.nodeAbi.Methods["gasEstimateComponents"].Outputs.Pack(uint64(300000), uint64(100000), big.NewInt(1e8), big.NewInt(3e10))
+	}
+	return nil, errors.New("unsupported call")
+}
+
+func TestEvmClient_Unite_EstimateFee(t *testing.T) {
+	oracleAbi, err := l2.GasPriceOracleMetaData.GetAbi()
+	assert.Nil(t, err)
+	nodeAbi, err := l2.NodeInterfaceMetaData.GetAbi()
+	assert.Nil(t, err)
+	service := &testFeeService{testTxService: testTxService{chainID: 1, nonce: 5}, oracleAbi: *oracleAbi, nodeAbi: *nodeAbi, balance: big.NewInt(0)}
+	services := map[string]interface{}{"eth": service}
+	ec := _testEvmClient(t, services, &clientModel.ConfEvmChainClient{GasFeeRate: decimal.NewFromInt(1)})
+	to := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	msg := ethereum.CallMsg{From: common.HexToAddress("0x1000000000000000000000000000000000000001"), To: &to, Value: big.NewInt(1)}
+
+	t.Run("Ethereum", func(t *testing.T) {
+		fee, err := ec.EstimateFee(testCtx, msg)
+		assert.Nil(t, err)
+		assert.Equal(t, consts.ChainFamilyEthereum, fee.ChainFamily)
+		assert.Equal(t, uint64(21000), fee.GasLimit)
+		assert.Equal(t, int64(21000*1e9), fee.Total.Int64())
+		assert.Equal(t, int64(0), fee.L1Fee.Int64())
+	})
+	t.Run("Optimism", func(t *testing.T) {
+		ec._chainFamily = consts.ChainFamilyOptimism
+		defer func() { ec._chainFamily = "" }()
+		fee, err := ec.EstimateFee(testCtx, msg)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(21000*1e9), fee.L2Fee.Int64())
+		assert.Equal(t, int64(5e12), fee.L1Fee.Int64())
+		assert.Equal(t, int64(21000*1e9+5e12), fee.Total.Int64())
+	})
+	t.Run("Arbitrum", func(t *testing.T) {
+		ec._chainFamily = consts.ChainFamilyArbitrum
+		defer func() { ec._chainFamily = "" }()
+		fee, err := ec.EstimateFee(testCtx, ethereum.CallMsg{From: msg.From, To: &to, GasPrice: big.NewInt(1e8)})
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(300000), fee.GasLimit)
+		assert.Equal(t, uint64(100000), fee.L1GasLimit)
+		assert.Equal(t, int64(200000*1e8), fee.L2Fee.Int64())
+		assert.Equal(t, int64(100000*1e8), fee.L1Fee.Int64())
+		assert.Equal(t, int64(300000*1e8), fee.Total.Int64())
+	})
+	t.Run("ChainFamily", func(t *testing.T) {
+		service.chainID = 10
+		defer func() { service.chainID = 1 }()
+		fee, err := ec.EstimateFee(testCtx, msg)
+		assert.Nil(t, err)
+		assert.Equal(t, consts.ChainFamilyOptimism, fee.ChainFamily)
+
+		arbitrum := _testEvmClient(t, services, &clientModel.ConfEvmChainClient{ChainFamily: consts.ChainFamilyArbitrum})
+		fee, err = arbitrum.EstimateFee(testCtx, msg)
+		assert.Nil(t, err)
+		assert.Equal(t, consts.ChainFamilyArbitrum, fee.ChainFamily)
+	})
+	t.Run("TransactOpts", func(t *testing.T) {
+		service.chainID = 10
+		defer func() { service.chainID = 1 }()
+		evmSigner, err := pk.TransformPkToEvmSigner("4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+		assert.Nil(t, err)
+		l2 := _testEvmClient(t, services, &clientModel.ConfEvmChainClient{GasFeeRate: decimal.NewFromInt(1), GasLimitRate: decimal.NewFromInt(2), Signers: []*clientModel.ConfEvmChainSigner{evmSigner}})
+		service.balance = big.NewInt(42000 * 1e9)
+		_, err = l2._getTransactOpts(testCtx, evmSigner.PublicAddress, to, "0x")
+		assert.ErrorContains(t, err, "l1 fee")
+		service.balance = big.NewInt(42000*1e9 + 5e12)
+		opts, err := l2._getTransactOpts(testCtx, evmSigner.PublicAddress, to, "0x")
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(42000), opts.GasLimit)
+
+		service.chainID = 42161
+		arbitrum := _testEvmClient(t, services, &clientModel.ConfEvmChainClient{GasFeeRate: decimal.NewFromInt(1), GasLimitRate: decimal.NewFromInt(1), GasLimitMax: decimal.NewFromInt(30000000), Signers: []*clientModel.ConfEvmChainSigner{evmSigner}})
+		opts, err = arbitrum._getTransactOpts(testCtx, evmSigner.PublicAddress, to, "0x")
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(300000), opts.GasLimit)
+	})
+}
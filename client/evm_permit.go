@@ -0,0 +1,630 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/6boris/web3-go/consts"
+	"github.com/6boris/web3-go/erc/erc20"
+	"github.com/6boris/web3-go/erc/erc2612"
+	"github.com/6boris/web3-go/erc/permit2"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/6boris/web3-go/pkg/pk"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// Permit2Address is the canonical Uniswap Permit2 deployment, identical on every chain.
+var Permit2Address = common.HexToAddress("0x000000000022D473030F116dDEE9F6B43aC78BA3")
+
+// ErrPermitDomainMismatch is returned when the locally built EIP-712 domain does not hash to the token DOMAIN_SEPARATOR,
+// a signature over it would be rejected by the token.
+var ErrPermitDomainMismatch = errors.New("permit domain separator mismatch")
+
+// ERC20PermitSignature is a signed EIP-2612 permit, ready to be submitted by anyone with ERC20Permit.
+type ERC20PermitSignature struct {
+	Token     common.Address
+	Owner     common.Address
+	Spender   common.Address
+	Value     *big.Int
+	Nonce     *big.Int
+	Deadline  *big.Int
+	V         uint8
+	R         [32]byte
+	S         [32]byte
+	Signature []byte
+}
+
+var (
+	permit2DetailsType = []apitypes.Type{
+		{Name: "token", Type: "address"},
+		{Name: "amount", Type: "uint160"},
+		{Name: "expiration", Type: "uint48"},
+		{Name: "nonce", Type: "uint48"},
+	}
+	permit2TokenPermissionsType = []apitypes.Type{
+		{Name: "token", Type: "address"},
+		{Name: "amount", Type: "uint256"},
+	}
+)
+
+// ERC20PermitTypedData builds the EIP-2612 Permit message for domain.
+func ERC20PermitTypedData(domain apitypes.TypedDataDomain, owner, spender common.Address, value, nonce, deadline *big.Int) apitypes.TypedData {
+	return pk.NormalizeTypedData(apitypes.TypedData{
+		Types: apitypes.Types{
+			"Permit": []apitypes.Type{
+				{Name: "owner", Type: "address"},
+				{Name: "spender", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "deadline", Type: "uint256"},
+			},
+		},
+		PrimaryType: "Permit",
+		Domain:      domain,
+		Message: apitypes.TypedDataMessage{
+			"owner":    owner.Hex(),
+			"spender":  spender.Hex(),
+			"value":    value.String(),
+			"nonce":    nonce.String(),
+			"deadline": deadline.String(),
+		},
+	})
+}
+
+// Permit2SingleTypedData builds the AllowanceTransfer PermitSingle message.
+func Permit2SingleTypedData(chainID *big.Int, permit permit2.IAllowanceTransferPermitSingle) apitypes.TypedData {
+	return pk.NormalizeTypedData(apitypes.TypedData{
+		Types: apitypes.Types{
+			"PermitSingle": []apitypes.Type{
+				{Name: "details", Type: "PermitDetails"},
+				{Name: "spender", Type: "address"},
+				{Name: "sigDeadline", Type: "uint256"},
+			},
+			"PermitDetails": permit2DetailsType,
+		},
+		PrimaryType: "PermitSingle",
+		Domain:      _permit2Domain(chainID),
+		Message: apitypes.TypedDataMessage{
+			"details":     _permit2DetailsMessage(permit.Details),
+			"spender":     permit.Spender.Hex(),
+			"sigDeadline": permit.SigDeadline.String(),
+		},
+	})
+}
+
+// Permit2BatchTypedData builds the AllowanceTransfer PermitBatch message.
+func Permit2BatchTypedData(chainID *big.Int, permit permit2.IAllowanceTransferPermitBatch) apitypes.TypedData {
+	details := make([]interface{}, 0, len(permit.Details))
+	for _, v := range permit.Details {
+		details = append(details, _permit2DetailsMessage(v))
+	}
+	return pk.NormalizeTypedData(apitypes.TypedData{
+		Types: apitypes.Types{
+			"PermitBatch": []apitypes.Type{
+				{Name: "details", Type: "PermitDetails[]"},
+				{Name: "spender", Type: "address"},
+				{Name: "sigDeadline", Type: "uint256"},
+			},
+			"PermitDetails": permit2DetailsType,
+		},
+		PrimaryType: "PermitBatch",
+		Domain:      _permit2Domain(chainID),
+		Message: apitypes.TypedDataMessage{
+			"details":     details,
+			"spender":     permit.Spender.Hex(),
+			"sigDeadline": permit.SigDeadline.String(),
+		},
+	})
+}
+
+// Permit2TransferFromTypedData builds the SignatureTransfer PermitTransferFrom message,
+// spender is the account that will submit permitTransferFrom.
+func Permit2TransferFromTypedData(chainID *big.Int, spender common.Address, permit permit2.ISignatureTransferPermitTransferFrom) apitypes.TypedData {
+	return pk.NormalizeTypedData(apitypes.TypedData{
+		Types: apitypes.Types{
+			"PermitTransferFrom": []apitypes.Type{
+				{Name: "permitted", Type: "TokenPermissions"},
+				{Name: "spender", Type: "address"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "deadline", Type: "uint256"},
+			},
+			"TokenPermissions": permit2TokenPermissionsType,
+		},
+		PrimaryType: "PermitTransferFrom",
+		Domain:      _permit2Domain(chainID),
+		Message: apitypes.TypedDataMessage{
+			"permitted": _permit2TokenPermissionsMessage(permit.Permitted),
+			"spender":   spender.Hex(),
+			"nonce":     permit.Nonce.String(),
+			"deadline":  permit.Deadline.String(),
+		},
+	})
+}
+
+// Permit2BatchTransferFromTypedData builds the SignatureTransfer PermitBatchTransferFrom message.
+func Permit2BatchTransferFromTypedData(chainID *big.Int, spender common.Address, permit permit2.ISignatureTransferPermitBatchTransferFrom) apitypes.TypedData {
+	permitted := make([]interface{}, 0, len(permit.Permitted))
+	for _, v := range permit.Permitted {
+		permitted = append(permitted, _permit2TokenPermissionsMessage(v))
+	}
+	return pk.NormalizeTypedData(apitypes.TypedData{
+		Types: apitypes.Types{
+			"PermitBatchTransferFrom": []apitypes.Type{
+				{Name: "permitted", Type: "TokenPermissions[]"},
+				{Name: "spender", Type: "address"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "deadline", Type: "uint256"},
+			},
+			"TokenPermissions": permit2TokenPermissionsType,
+		},
+		PrimaryType: "PermitBatchTransferFrom",
+		Domain:      _permit2Domain(chainID),
+		Message: apitypes.TypedDataMessage{
+			"permitted": permitted,
+			"spender":   spender.Hex(),
+			"nonce":     permit.Nonce.String(),
+			"deadline":  permit.Deadline.String(),
+		},
+	})
+}
+
+func (ec *EvmClient) ERC20PermitNonce(ctx context.Context, token common.Address, owner common.Address) (*big.Int, error) {
+	return ec.ERC20PermitNonceWithBlock(ctx, token, owner, BlockRef{})
+}
+func (ec *EvmClient) ERC20PermitNonceWithBlock(ctx context.Context, token common.Address, owner common.Address, block BlockRef) (*big.Int, error) {
+	abiMethod := consts.EvmErc2612MethodNonces
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc2612.NewERC2612(token, ec.ethClient)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return big.NewInt(0), err
+	}
+	opts, err := ec._getCallOpts(ctx, block)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return big.NewInt(0), err
+	}
+	callResp, err := inst.Nonces(opts, owner)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return big.NewInt(0), err
+	}
+	return callResp, nil
+}
+
+// ERC20PermitDomain reads the EIP-712 domain of token. version() is optional and defaults to "1",
+// the result is checked against DOMAIN_SEPARATOR.
+func (ec *EvmClient) ERC20PermitDomain(ctx context.Context, token common.Address) (apitypes.TypedDataDomain, error) {
+	abiMethod := consts.EvmErc2612MethodDomainSeparator
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc2612.NewERC2612(token, ec.ethClient)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return apitypes.TypedDataDomain{}, err
+	}
+	erc20Inst, err := erc20.NewERC20(token, ec.ethClient)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return apitypes.TypedDataDomain{}, err
+	}
+	opts, err := ec._getCallOpts(ctx, BlockRef{})
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return apitypes.TypedDataDomain{}, err
+	}
+	chainID, err := ec.ethClient.ChainID(ctx)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return apitypes.TypedDataDomain{}, err
+	}
+	domainSeparator, err := inst.DOMAINSEPARATOR(opts)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return apitypes.TypedDataDomain{}, err
+	}
+	name, err := erc20Inst.Name(opts)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return apitypes.TypedDataDomain{}, err
+	}
+	version, err := inst.Version(opts)
+	if err != nil || version == "" {
+		version = "1"
+	}
+	domain := apitypes.TypedDataDomain{
+		Name:              name,
+		Version:           version,
+		ChainId:           (*math.HexOrDecimal256)(chainID),
+		VerifyingContract: token.Hex(),
+	}
+	typedData := ERC20PermitTypedData(domain, common.Address{}, common.Address{}, big.NewInt(0), big.NewInt(0), big.NewInt(0))
+	_, localSeparator, err := pk.TypedDataHash(typedData)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return apitypes.TypedDataDomain{}, err
+	}
+	if !bytes.Equal(localSeparator, domainSeparator[:]) {
+		meta.Status = consts.AbiCallStatusFail
+		return apitypes.TypedDataDomain{}, ErrPermitDomainMismatch
+	}
+	return domain, nil
+}
+
+// ERC20SignPermit signs an EIP-2612 permit letting spender move value of owner's token until deadline.
+// owner must be a configured signer, the nonce is read from the token.
+func (ec *EvmClient) ERC20SignPermit(ctx context.Context, token common.Address, owner common.Address, spender common.Address, value *big.Int, deadline *big.Int) (*ERC20PermitSignature, error) {
+	abiMethod := consts.EvmErc2612MethodSignPermit
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	domain, err := ec.ERC20PermitDomain(ctx, token)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	nonce, err := ec.ERC20PermitNonce(ctx, token, owner)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	signature, err := ec._signTypedData(ctx, owner, ERC20PermitTypedData(domain, owner, spender, value, nonce, deadline))
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	permit := &ERC20PermitSignature{
+		Token:     token,
+		Owner:     owner,
+		Spender:   spender,
+		Value:     value,
+		Nonce:     nonce,
+		Deadline:  deadline,
+		V:         signature[64],
+		Signature: signature,
+	}
+	copy(permit.R[:], signature[:32])
+	copy(permit.S[:], signature[32:64])
+	return permit, nil
+}
+
+// ERC20Permit submits a signed permit from signer, usually the spender or a relayer.
+func (ec *EvmClient) ERC20Permit(ctx context.Context, signer common.Address, permit *ERC20PermitSignature) (*types.Transaction, error) {
+	abiMethod := consts.EvmErc2612MethodPermit
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc2612.NewERC2612(permit.Token, ec._contractBackend())
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abi, err := erc2612.ERC2612MetaData.GetAbi()
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abiData, err := abi.Pack("permit", permit.Owner, permit.Spender, permit.Value, permit.Deadline, permit.V, permit.R, permit.S)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	opts, err := ec._getTransactOpts(ctx, signer, permit.Token, common.Bytes2Hex(abiData))
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	callResp, err := inst.Permit(opts, permit.Owner, permit.Spender, permit.Value, permit.Deadline, permit.V, permit.R, permit.S)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return callResp, nil
+}
+
+// Permit2Allowance returns the AllowanceTransfer state of (owner, token, spender), its nonce is the one PermitSingle
+// and PermitBatch details must carry.
+func (ec *EvmClient) Permit2Allowance(ctx context.Context, owner common.Address, token common.Address, spender common.Address) (amount *big.Int, expiration *big.Int, nonce *big.Int, err error) {
+	return ec.Permit2AllowanceWithBlock(ctx, owner, token, spender, BlockRef{})
+}
+func (ec *EvmClient) Permit2AllowanceWithBlock(ctx context.Context, owner common.Address, token common.Address, spender common.Address, block BlockRef) (amount *big.Int, expiration *big.Int, nonce *big.Int, err error) {
+	abiMethod := consts.EvmPermit2MethodAllowance
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := permit2.NewPermit2(Permit2Address, ec.ethClient)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, nil, nil, err
+	}
+	opts, err := ec._getCallOpts(ctx, block)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, nil, nil, err
+	}
+	callResp, err := inst.Allowance(opts, owner, token, spender)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, nil, nil, err
+	}
+	return callResp.Amount, callResp.Expiration, callResp.Nonce, nil
+}
+func (ec *EvmClient) Permit2SignSingle(ctx context.Context, owner common.Address, permit permit2.IAllowanceTransferPermitSingle) ([]byte, error) {
+	abiMethod := consts.EvmPermit2MethodSign
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	chainID, err := ec.ethClient.ChainID(ctx)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	signature, err := ec._signTypedData(ctx, owner, Permit2SingleTypedData(chainID, permit))
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return signature, nil
+}
+func (ec *EvmClient) Permit2SignBatch(ctx context.Context, owner common.Address, permit permit2.IAllowanceTransferPermitBatch) ([]byte, error) {
+	abiMethod := consts.EvmPermit2MethodSign
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	chainID, err := ec.ethClient.ChainID(ctx)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	signature, err := ec._signTypedData(ctx, owner, Permit2BatchTypedData(chainID, permit))
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return signature, nil
+}
+func (ec *EvmClient) Permit2SignTransferFrom(ctx context.Context, owner common.Address, spender common.Address, permit permit2.ISignatureTransferPermitTransferFrom) ([]byte, error) {
+	abiMethod := consts.EvmPermit2MethodSign
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	chainID, err := ec.ethClient.ChainID(ctx)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	signature, err := ec._signTypedData(ctx, owner, Permit2TransferFromTypedData(chainID, spender, permit))
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return signature, nil
+}
+func (ec *EvmClient) Permit2SignBatchTransferFrom(ctx context.Context, owner common.Address, spender common.Address, permit permit2.ISignatureTransferPermitBatchTransferFrom) ([]byte, error) {
+	abiMethod := consts.EvmPermit2MethodSign
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	chainID, err := ec.ethClient.ChainID(ctx)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	signature, err := ec._signTypedData(ctx, owner, Permit2BatchTransferFromTypedData(chainID, spender, permit))
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return signature, nil
+}
+
+// Permit2Permit submits a signed PermitSingle, setting the Permit2 allowance of owner.
+func (ec *EvmClient) Permit2Permit(ctx context.Context, signer common.Address, owner common.Address, permit permit2.IAllowanceTransferPermitSingle, signature []byte) (*types.Transaction, error) {
+	abiMethod := consts.EvmPermit2MethodPermit
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := permit2.NewPermit2(Permit2Address, ec._contractBackend())
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abi, err := permit2.Permit2MetaData.GetAbi()
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abiData, err := abi.Pack("permit", owner, permit, signature)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	opts, err := ec._getTransactOpts(ctx, signer, Permit2Address, common.Bytes2Hex(abiData))
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	callResp, err := inst.Permit(opts, owner, permit, signature)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return callResp, nil
+}
+
+// Permit2PermitBatch submits a signed PermitBatch.
+func (ec *EvmClient) Permit2PermitBatch(ctx context.Context, signer common.Address, owner common.Address, permit permit2.IAllowanceTransferPermitBatch, signature []byte) (*types.Transaction, error) {
+	abiMethod := consts.EvmPermit2MethodPermit
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := permit2.NewPermit2(Permit2Address, ec._contractBackend())
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abi, err := permit2.Permit2MetaData.GetAbi()
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abiData, err := abi.Pack("permit0", owner, permit, signature)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	opts, err := ec._getTransactOpts(ctx, signer, Permit2Address, common.Bytes2Hex(abiData))
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	callResp, err := inst.Permit0(opts, owner, permit, signature)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return callResp, nil
+}
+
+// Permit2PermitTransferFrom moves tokens of owner with a SignatureTransfer signature, signer must be the spender it was signed for.
+func (ec *EvmClient) Permit2PermitTransferFrom(ctx context.Context, signer common.Address, permit permit2.ISignatureTransferPermitTransferFrom, transferDetails permit2.ISignatureTransferSignatureTransferDetails, owner common.Address, signature []byte) (*types.Transaction, error) {
+	abiMethod := consts.EvmPermit2MethodPermitTransferFrom
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := permit2.NewPermit2(Permit2Address, ec._contractBackend())
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abi, err := permit2.Permit2MetaData.GetAbi()
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abiData, err := abi.Pack("permitTransferFrom", permit, transferDetails, owner, signature)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	opts, err := ec._getTransactOpts(ctx, signer, Permit2Address, common.Bytes2Hex(abiData))
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	callResp, err := inst.PermitTransferFrom(opts, permit, transferDetails, owner, signature)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return callResp, nil
+}
+
+// Permit2PermitBatchTransferFrom is the batch form of Permit2PermitTransferFrom, transferDetails matches permit.Permitted by index.
+func (ec *EvmClient) Permit2PermitBatchTransferFrom(ctx context.Context, signer common.Address, permit permit2.ISignatureTransferPermitBatchTransferFrom, transferDetails []permit2.ISignatureTransferSignatureTransferDetails, owner common.Address, signature []byte) (*types.Transaction, error) {
+	abiMethod := consts.EvmPermit2MethodPermitTransferFrom
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := permit2.NewPermit2(Permit2Address, ec._contractBackend())
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abi, err := permit2.Permit2MetaData.GetAbi()
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	abiData, err := abi.Pack("permitTransferFrom0", permit, transferDetails, owner, signature)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	opts, err := ec._getTransactOpts(ctx, signer, Permit2Address, common.Bytes2Hex(abiData))
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	callResp, err := inst.PermitTransferFrom0(opts, permit, transferDetails, owner, signature)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return callResp, nil
+}
+
+// _signTypedData signs typedData with owner and normalizes V to {27, 28} as expected by permit and Safe
+// verifiers. A signer with a policy evaluates the spender and amounts of the message before signing.
+func (ec *EvmClient) _signTypedData(ctx context.Context, owner common.Address, typedData apitypes.TypedData) ([]byte, error) {
+	msgSigner, err := ec._getSigner(owner)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := msgSigner.SignTypedData(ctx, typedData)
+	if err != nil {
+		return nil, err
+	}
+	if len(signature) != 65 {
+		return nil, errors.New("invalid signature length")
+	}
+	if signature[64] < 27 {
+		signature[64] += 27
+	}
+	return signature, nil
+}
+func _permit2Domain(chainID *big.Int) apitypes.TypedDataDomain {
+	return apitypes.TypedDataDomain{
+		Name:              "Permit2",
+		ChainId:           (*math.HexOrDecimal256)(chainID),
+		VerifyingContract: Permit2Address.Hex(),
+	}
+}
+func _permit2DetailsMessage(details permit2.IAllowanceTransferPermitDetails) map[string]interface{} {
+	return map[string]interface{}{
+		"token":      details.Token.Hex(),
+		"amount":     details.Amount.String(),
+		"expiration": details.Expiration.String(),
+		"nonce":      details.Nonce.String(),
+	}
+}
+func _permit2TokenPermissionsMessage(permitted permit2.ISignatureTransferTokenPermissions) map[string]interface{} {
+	return map[string]interface{}{
+		"token":  permitted.Token.Hex(),
+		"amount": permitted.Amount.String(),
+	}
+}
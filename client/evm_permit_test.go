@@ -0,0 +1,108 @@
+package client
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/6boris/web3-go/erc/permit2"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/6boris/web3-go/pkg/pk"
+	"github.com/6boris/web3-go/pkg/policy"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvmClient_Unite_Permit(t *testing.T) {
+	signer, err := pk.TransformPkToEvmSigner(hex.EncodeToString(crypto.Keccak256([]byte("cow"))))
+	assert.Nil(t, err)
+	ec := _testEvmClient(t, map[string]interface{}{"eth": &testTxService{}}, &clientModel.ConfEvmChainClient{
+		Signers: []*clientModel.ConfEvmChainSigner{signer},
+	})
+	spender := common.HexToAddress("0x3fC91A3afd70395Cd496C647d5a6CC9D4B2b7FAD")
+	token := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+
+	t.Run("ERC20PermitTypedData", func(t *testing.T) {
+		// USDC on Ethereum mainnet
+		domain := apitypes.TypedDataDomain{
+			Name:              "USD Coin",
+			Version:           "2",
+			ChainId:           math.NewHexOrDecimal256(1),
+			VerifyingContract: token.Hex(),
+		}
+		typedData := ERC20PermitTypedData(domain, signer.PublicAddress, spender, big.NewInt(1000000), big.NewInt(0), big.NewInt(1700000000))
+		_, domainSeparator, err := pk.TypedDataHash(typedData)
+		assert.Nil(t, err)
+		assert.Equal(t, "0x06c37168a7db5138defc7866392bb87a741f9b3d104deb5094588ce041cae335", common.BytesToHash(domainSeparator).Hex())
+		signature, err := ec._signTypedData(testCtx, signer.PublicAddress, typedData)
+		assert.Nil(t, err)
+		recovered, err := pk.RecoverTypedDataSigner(typedData, signature)
+		assert.Nil(t, err)
+		assert.Equal(t, signer.PublicAddress, recovered)
+	})
+	t.Run("Permit2SignSingle", func(t *testing.T) {
+		permit := permit2.IAllowanceTransferPermitSingle{
+			Details: permit2.IAllowanceTransferPermitDetails{
+				Token: token, Amount: big.NewInt(1000000), Expiration: big.NewInt(1700000000), Nonce: big.NewInt(0),
+			},
+			Spender:     spender,
+			SigDeadline: big.NewInt(1700000000),
+		}
+		signature, err := ec.Permit2SignSingle(testCtx, signer.PublicAddress, permit)
+		assert.Nil(t, err)
+		assert.True(t, signature[64] == 27 || signature[64] == 28)
+		recovered, err := pk.RecoverTypedDataSigner(Permit2SingleTypedData(big.NewInt(1), permit), signature)
+		assert.Nil(t, err)
+		assert.Equal(t, signer.PublicAddress, recovered)
+	})
+	t.Run("Permit2SignBatchTransferFrom", func(t *testing.T) {
+		permit := permit2.ISignatureTransferPermitBatchTransferFrom{
+			Permitted: []permit2.ISignatureTransferTokenPermissions{
+				{Token: token, Amount: big.NewInt(1)},
+				{Token: spender, Amount: big.NewInt(2)},
+			},
+			Nonce:    big.NewInt(7),
+			Deadline: big.NewInt(1700000000),
+		}
+		signature, err := ec.Permit2SignBatchTransferFrom(testCtx, signer.PublicAddress, spender, permit)
+		assert.Nil(t, err)
+		recovered, err := pk.RecoverTypedDataSigner(Permit2BatchTransferFromTypedData(big.NewInt(1), spender, permit), signature)
+		assert.Nil(t, err)
+		assert.Equal(t, signer.PublicAddress, recovered)
+		// the signature is bound to the spender
+		recovered, err = pk.RecoverTypedDataSigner(Permit2BatchTransferFromTypedData(big.NewInt(1), token, permit), signature)
+		assert.Nil(t, err)
+		assert.NotEqual(t, signer.PublicAddress, recovered)
+	})
+	t.Run("Policy", func(t *testing.T) {
+		policySigner := *signer
+		policySigner.Policy = &clientModel.ConfEvmSignerPolicy{
+			RecipientAllowlist: []common.Address{spender},
+			TokenLimits: map[common.Address]*clientModel.ConfEvmValueLimit{
+				token: {PerTx: decimal.NewFromInt(1000000)},
+			},
+		}
+		policyClient := _testEvmClient(t, map[string]interface{}{"eth": &testTxService{}}, &clientModel.ConfEvmChainClient{
+			Signers: []*clientModel.ConfEvmChainSigner{&policySigner},
+		})
+		permit := permit2.IAllowanceTransferPermitSingle{
+			Details: permit2.IAllowanceTransferPermitDetails{
+				Token: token, Amount: big.NewInt(1000000), Expiration: big.NewInt(1700000000), Nonce: big.NewInt(0),
+			},
+			Spender:     spender,
+			SigDeadline: big.NewInt(1700000000),
+		}
+		_, err = policyClient.Permit2SignSingle(testCtx, signer.PublicAddress, permit)
+		assert.Nil(t, err)
+		permit.Spender = token
+		_, err = policyClient.Permit2SignSingle(testCtx, signer.PublicAddress, permit)
+		assert.ErrorIs(t, err, policy.ErrDenied)
+		permit.Spender, permit.Details.Amount = spender, big.NewInt(1000001)
+		_, err = policyClient.Permit2SignSingle(testCtx, signer.PublicAddress, permit)
+		assert.ErrorIs(t, err, policy.ErrDenied)
+	})
+}
@@ -0,0 +1,221 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/6boris/web3-go/consts"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+var (
+	ErrProofInvalid  = errors.New("merkle proof invalid")
+	ErrProofMismatch = errors.New("merkle proof does not match the claimed value")
+)
+
+// AccountProof is the eth_getProof (EIP-1186) result of one account.
+type AccountProof struct {
+	Address      common.Address
+	Balance      *big.Int
+	Nonce        uint64
+	CodeHash     common.Hash
+	StorageHash  common.Hash
+	AccountProof [][]byte
+	StorageProof []*StorageProof
+}
+
+type StorageProof struct {
+	Key   common.Hash
+	Value *big.Int
+	Proof [][]byte
+}
+
+type accountProofResult struct {
+	Address      common.Address       `json:"address"`
+	Balance      *hexutil.Big         `json:"balance"`
+	Nonce        hexutil.Uint64       `json:"nonce"`
+	CodeHash     common.Hash          `json:"codeHash"`
+	StorageHash  common.Hash          `json:"storageHash"`
+	AccountProof []hexutil.Bytes      `json:"accountProof"`
+	StorageProof []storageProofResult `json:"storageProof"`
+}
+type storageProofResult struct {
+	Key   string          `json:"key"`
+	Value *hexutil.Big    `json:"value"`
+	Proof []hexutil.Bytes `json:"proof"`
+}
+
+// GetProof returns the account and storage proofs of account at block (nil for latest). The result is
+// not verified, see VerifyAccountProof and GetVerifiedProof.
+func (ec *EvmClient) GetProof(ctx context.Context, account common.Address, storageKeys []common.Hash, blockNumber *big.Int) (*AccountProof, error) {
+	abiMethod := consts.EvmMethodGetProof
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	result, err := ec._getProof(ctx, account, storageKeys, blockNumber)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetVerifiedProof fetches the header of block and the proofs at the same height, and verifies them
+// against the header stateRoot, so the returned balance, nonce and storage values can be trusted as
+// far as the header is.
+func (ec *EvmClient) GetVerifiedProof(ctx context.Context, account common.Address, storageKeys []common.Hash, blockNumber *big.Int) (*AccountProof, *types.Header, error) {
+	abiMethod := consts.EvmMethodGetVerifiedProof
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	header, err := ec.ethClient.HeaderByNumber(ctx, blockNumber)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, nil, err
+	}
+	proof, err := ec._getProof(ctx, account, storageKeys, header.Number)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, nil, err
+	}
+	if err = VerifyAccountProof(header.Root, proof); err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, nil, err
+	}
+	return proof, header, nil
+}
+
+func (ec *EvmClient) _getProof(ctx context.Context, account common.Address, storageKeys []common.Hash, blockNumber *big.Int) (*AccountProof, error) {
+	keys := make([]string, 0, len(storageKeys))
+	for _, key := range storageKeys {
+		keys = append(keys, key.Hex())
+	}
+	var res accountProofResult
+	if err := ec.rpcClient.CallContext(ctx, &res, "eth_getProof", account, keys, _toBlockNumArg(blockNumber)); err != nil {
+		return nil, err
+	}
+	result := &AccountProof{
+		Address:      res.Address,
+		Balance:      (*big.Int)(res.Balance),
+		Nonce:        uint64(res.Nonce),
+		CodeHash:     res.CodeHash,
+		StorageHash:  res.StorageHash,
+		AccountProof: _proofBytes(res.AccountProof),
+		StorageProof: make([]*StorageProof, 0, len(res.StorageProof)),
+	}
+	if result.Balance == nil {
+		result.Balance = new(big.Int)
+	}
+	for _, sp := range res.StorageProof {
+		value := (*big.Int)(sp.Value)
+		if value == nil {
+			value = new(big.Int)
+		}
+		result.StorageProof = append(result.StorageProof, &StorageProof{
+			Key:   common.HexToHash(sp.Key),
+			Value: value,
+			Proof: _proofBytes(sp.Proof),
+		})
+	}
+	return result, nil
+}
+
+// VerifyAccountProof checks the account fields of proof against stateRoot and every storage value
+// against the proven storageHash. A missing account or slot must be claimed as empty or zero.
+func VerifyAccountProof(stateRoot common.Hash, proof *AccountProof) error {
+	value, err := VerifyMerkleProof(stateRoot, crypto.Keccak256(proof.Address.Bytes()), proof.AccountProof)
+	if err != nil {
+		return err
+	}
+	if value == nil {
+		empty := proof.Nonce == 0 && proof.Balance.Sign() == 0 &&
+			(proof.CodeHash == types.EmptyCodeHash || proof.CodeHash == common.Hash{}) &&
+			(proof.StorageHash == types.EmptyRootHash || proof.StorageHash == common.Hash{})
+		if !empty {
+			return fmt.Errorf("%w: account %s does not exist", ErrProofMismatch, proof.Address.Hex())
+		}
+	} else {
+		var account types.StateAccount
+		if err = rlp.DecodeBytes(value, &account); err != nil {
+			return fmt.Errorf("%w: %v", ErrProofInvalid, err)
+		}
+		if account.Nonce != proof.Nonce || account.Balance.ToBig().Cmp(proof.Balance) != 0 ||
+			common.BytesToHash(account.CodeHash) != proof.CodeHash || account.Root != proof.StorageHash {
+			return fmt.Errorf("%w: account %s", ErrProofMismatch, proof.Address.Hex())
+		}
+	}
+	for _, sp := range proof.StorageProof {
+		if err = VerifyStorageProof(proof.StorageHash, sp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VerifyStorageProof checks one storage slot against the account storageRoot.
+func VerifyStorageProof(storageRoot common.Hash, proof *StorageProof) error {
+	if storageRoot == (common.Hash{}) {
+		storageRoot = types.EmptyRootHash
+	}
+	value, err := VerifyMerkleProof(storageRoot, crypto.Keccak256(proof.Key.Bytes()), proof.Proof)
+	if err != nil {
+		return err
+	}
+	proven := new(big.Int)
+	if value != nil {
+		content, _, err := rlp.SplitString(value)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrProofInvalid, err)
+		}
+		proven.SetBytes(content)
+	}
+	if proven.Cmp(proof.Value) != 0 {
+		return fmt.Errorf("%w: slot %s", ErrProofMismatch, proof.Key.Hex())
+	}
+	return nil
+}
+
+// VerifyMerkleProof checks a Merkle Patricia trie proof from root along key with trie.VerifyProof and
+// returns the stored value, nil when the proof shows the key is absent. The trie package links geth's
+// database stack (pebble, go-verkle) into the client, the price of reusing geth's node decoding instead
+// of keeping a second copy of it here.
+func VerifyMerkleProof(root common.Hash, key []byte, proof [][]byte) ([]byte, error) {
+	if root == types.EmptyRootHash && len(proof) == 0 {
+		return nil, nil
+	}
+	proofDB := memorydb.New()
+	for _, node := range proof {
+		if err := proofDB.Put(crypto.Keccak256(node), node); err != nil {
+			return nil, err
+		}
+	}
+	value, err := trie.VerifyProof(root, key, proofDB)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProofInvalid, err)
+	}
+	if len(value) == 0 {
+		return nil, nil
+	}
+	return value, nil
+}
+
+func _proofBytes(proof []hexutil.Bytes) [][]byte {
+	result := make([][]byte, 0, len(proof))
+	for _, node := range proof {
+		result = append(result, node)
+	}
+	return result
+}
@@ -0,0 +1,190 @@
+package client
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+)
+
+// testProofService serves a state trie of two accounts below a branch root; the first account has a
+// storage trie with a single slot.
+type testProofService struct {
+	root     common.Hash
+	branch   []byte
+	leaves   map[common.Address][]byte
+	accounts map[common.Address]*testProofAccount
+	slotKey  common.Hash
+	slotLeaf []byte
+	tamper   bool
+}
+
+// testProofAccount has the RLP layout of types.StateAccount.
+type testProofAccount struct {
+	Nonce    uint64
+	Balance  *big.Int
+	Root     common.Hash
+	CodeHash []byte
+}
+
+func testKeyNibbles(key []byte) []byte {
+	nibbles := make([]byte, 0, len(key)*2)
+	for _, b := range key {
+		nibbles = append(nibbles, b>>4, b&0x0f)
+	}
+	return nibbles
+}
+
+func testCompactPath(nibbles []byte) []byte {
+	flag := byte(2)
+	if len(nibbles)%2 == 1 {
+		flag, nibbles = (flag+1)<<4|nibbles[0], nibbles[1:]
+	} else {
+		flag <<= 4
+	}
+	compact := []byte{flag}
+	for i := 0; i < len(nibbles); i += 2 {
+		compact = append(compact, nibbles[i]<<4|nibbles[i+1])
+	}
+	return compact
+}
+
+func testLeaf(path []byte, value []byte) []byte {
+	leaf, err := rlp.EncodeToBytes([]interface{}{testCompactPath(path), value})
+	if err != nil {
+		panic(err)
+	}
+	return leaf
+}
+
+func (s *testProofService) GetProof(address common.Address, keys []string, block string) (map[string]interface{}, error) {
+	_ = block
+	proof := []hexutil.Bytes{s.branch}
+	if leaf, ok := s.leaves[address]; ok {
+		proof = append(proof, leaf)
+	}
+	result := map[string]interface{}{
+		"address":      address,
+		"balance":      "0x0",
+		"nonce":        "0x0",
+		"codeHash":     types.EmptyCodeHash,
+		"storageHash":  types.EmptyRootHash,
+		"accountProof": proof,
+	}
+	if account, ok := s.accounts[address]; ok {
+		balance := new(big.Int).Set(account.Balance)
+		if s.tamper {
+			balance.Add(balance, big.NewInt(1))
+		}
+		result["balance"] = (*hexutil.Big)(balance)
+		result["nonce"] = hexutil.Uint64(account.Nonce)
+		result["codeHash"] = common.BytesToHash(account.CodeHash)
+		result["storageHash"] = account.Root
+	}
+	storageProof := make([]map[string]interface{}, 0, len(keys))
+	for _, key := range keys {
+		sp := map[string]interface{}{"key": key, "value": "0x0", "proof": []hexutil.Bytes{}}
+		if account, ok := s.accounts[address]; ok && account.Root != types.EmptyRootHash {
+			sp["proof"] = []hexutil.Bytes{s.slotLeaf}
+			if common.HexToHash(key) == s.slotKey {
+				sp["value"] = "0x2a"
+			}
+		}
+		storageProof = append(storageProof, sp)
+	}
+	result["storageProof"] = storageProof
+	return result, nil
+}
+
+func (s *testProofService) GetBlockByNumber(block string, full bool) (*types.Header, error) {
+	_, _ = block, full
+	return &types.Header{Number: big.NewInt(100), Root: s.root, Difficulty: big.NewInt(0)}, nil
+}
+
+func TestEvmClient_Unite_Proof(t *testing.T) {
+	// pick addresses so that alice and bob sit below different branch children and carol shares
+	// alice's child, dave an empty one
+	var alice, bob, carol, dave common.Address
+	used := map[byte]bool{}
+	for i := int64(1); dave == (common.Address{}); i++ {
+		address := common.BigToAddress(big.NewInt(i))
+		nibble := crypto.Keccak256(address.Bytes())[0] >> 4
+		switch {
+		case alice == (common.Address{}):
+			alice, used[nibble] = address, true
+		case bob == (common.Address{}) && !used[nibble]:
+			bob, used[nibble] = address, true
+		case carol == (common.Address{}) && nibble == crypto.Keccak256(alice.Bytes())[0]>>4:
+			carol = address
+		case carol != (common.Address{}) && !used[nibble]:
+			dave = address
+		}
+	}
+
+	service := &testProofService{slotKey: common.BigToHash(big.NewInt(0))}
+	slotValue, _ := rlp.EncodeToBytes(big.NewInt(42))
+	service.slotLeaf = testLeaf(testKeyNibbles(crypto.Keccak256(service.slotKey.Bytes())), slotValue)
+	service.accounts = map[common.Address]*testProofAccount{
+		alice: {Nonce: 3, Balance: big.NewInt(1e18), Root: crypto.Keccak256Hash(service.slotLeaf), CodeHash: crypto.Keccak256([]byte{0x60, 0x00})},
+		bob:   {Nonce: 1, Balance: big.NewInt(5), Root: types.EmptyRootHash, CodeHash: types.EmptyCodeHash.Bytes()},
+	}
+	service.leaves = map[common.Address][]byte{}
+	children := make([]interface{}, 17)
+	for i := range children {
+		children[i] = []byte{}
+	}
+	for address, account := range service.accounts {
+		value, err := rlp.EncodeToBytes(account)
+		assert.Nil(t, err)
+		path := testKeyNibbles(crypto.Keccak256(address.Bytes()))
+		service.leaves[address] = testLeaf(path[1:], value)
+		children[path[0]] = crypto.Keccak256(service.leaves[address])
+	}
+	service.leaves[carol] = service.leaves[alice]
+	branch, err := rlp.EncodeToBytes(children)
+	assert.Nil(t, err)
+	service.branch, service.root = branch, crypto.Keccak256Hash(branch)
+
+	ec := _testEvmClient(t, map[string]interface{}{"eth": service}, nil)
+
+	t.Run("GetVerifiedProof", func(t *testing.T) {
+		proof, header, err := ec.GetVerifiedProof(testCtx, alice, []common.Hash{service.slotKey, common.BigToHash(big.NewInt(1))}, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(100), header.Number.Int64())
+		assert.Equal(t, int64(1e18), proof.Balance.Int64())
+		assert.Equal(t, uint64(3), proof.Nonce)
+		assert.Equal(t, int64(42), proof.StorageProof[0].Value.Int64())
+		assert.Equal(t, int64(0), proof.StorageProof[1].Value.Int64())
+
+		proof, _, err = ec.GetVerifiedProof(testCtx, bob, nil, big.NewInt(100))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(5), proof.Balance.Int64())
+	})
+	t.Run("AbsentAccount", func(t *testing.T) {
+		for _, address := range []common.Address{carol, dave} {
+			proof, _, err := ec.GetVerifiedProof(testCtx, address, nil, nil)
+			assert.Nil(t, err)
+			assert.Equal(t, int64(0), proof.Balance.Int64())
+		}
+	})
+	t.Run("Tampered", func(t *testing.T) {
+		service.tamper = true
+		defer func() { service.tamper = false }()
+		_, _, err := ec.GetVerifiedProof(testCtx, alice, nil, nil)
+		assert.ErrorIs(t, err, ErrProofMismatch)
+
+		proof, err := ec.GetProof(testCtx, alice, []common.Hash{service.slotKey}, nil)
+		assert.Nil(t, err)
+		proof.Balance.Sub(proof.Balance, big.NewInt(1))
+		assert.Nil(t, VerifyAccountProof(service.root, proof))
+		proof.StorageProof[0].Value = big.NewInt(43)
+		assert.ErrorIs(t, VerifyAccountProof(service.root, proof), ErrProofMismatch)
+		proof.AccountProof = proof.AccountProof[:1]
+		assert.ErrorIs(t, VerifyAccountProof(service.root, proof), ErrProofInvalid)
+	})
+}
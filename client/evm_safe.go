@@ -0,0 +1,359 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/6boris/web3-go/consts"
+	"github.com/6boris/web3-go/erc/safe"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/6boris/web3-go/pkg/pk"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const (
+	SafeOperationCall         uint8 = 0
+	SafeOperationDelegateCall uint8 = 1
+)
+
+// Canonical v1.3.0 MultiSend deployments. MultiSendCallOnly refuses nested delegate calls.
+var (
+	SafeMultiSendAddress         = common.HexToAddress("0xA238CBeb142c10Ef7Ad8442C6D1f9E89e07e7761")
+	SafeMultiSendCallOnlyAddress = common.HexToAddress("0x40A2aCCbd92BCA938b02010E17A5b8929b49130D")
+)
+
+var (
+	ErrSafeNotOwner        = errors.New("signer is not an owner of the safe")
+	ErrSafeThresholdNotMet = errors.New("safe signatures below threshold")
+)
+
+// SafeTransaction is the SafeTx signed by the owners. A nil Nonce is filled with the current Safe nonce.
+type SafeTransaction struct {
+	To             common.Address
+	Value          *big.Int
+	Data           []byte
+	Operation      uint8
+	SafeTxGas      *big.Int
+	BaseGas        *big.Int
+	GasPrice       *big.Int
+	GasToken       common.Address
+	RefundReceiver common.Address
+	Nonce          *big.Int
+}
+
+type SafeMultiSendCall struct {
+	Operation uint8
+	To        common.Address
+	Value     *big.Int
+	Data      []byte
+}
+
+func (ec *EvmClient) SafeNonce(ctx context.Context, safeAddress common.Address) (*big.Int, error) {
+	return ec.SafeNonceWithBlock(ctx, safeAddress, BlockRef{})
+}
+func (ec *EvmClient) SafeNonceWithBlock(ctx context.Context, safeAddress common.Address, block BlockRef) (*big.Int, error) {
+	abiMethod := consts.EvmSafeMethodNonce
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := safe.NewSafe(safeAddress, ec.ethClient)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	opts, err := ec._getCallOpts(ctx, block)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	callResp, err := inst.Nonce(opts)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return callResp, nil
+}
+func (ec *EvmClient) SafeGetOwners(ctx context.Context, safeAddress common.Address) ([]common.Address, error) {
+	return ec.SafeGetOwnersWithBlock(ctx, safeAddress, BlockRef{})
+}
+func (ec *EvmClient) SafeGetOwnersWithBlock(ctx context.Context, safeAddress common.Address, block BlockRef) ([]common.Address, error) {
+	abiMethod := consts.EvmSafeMethodGetOwners
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := safe.NewSafe(safeAddress, ec.ethClient)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	opts, err := ec._getCallOpts(ctx, block)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	callResp, err := inst.GetOwners(opts)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return callResp, nil
+}
+func (ec *EvmClient) SafeGetThreshold(ctx context.Context, safeAddress common.Address) (*big.Int, error) {
+	return ec.SafeGetThresholdWithBlock(ctx, safeAddress, BlockRef{})
+}
+func (ec *EvmClient) SafeGetThresholdWithBlock(ctx context.Context, safeAddress common.Address, block BlockRef) (*big.Int, error) {
+	abiMethod := consts.EvmSafeMethodGetThreshold
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := safe.NewSafe(safeAddress, ec.ethClient)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	opts, err := ec._getCallOpts(ctx, block)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	callResp, err := inst.GetThreshold(opts)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return callResp, nil
+}
+
+// SafeTypedData builds the EIP-712 SafeTx message of Safe v1.3.0 and later.
+func SafeTypedData(chainID *big.Int, safeAddress common.Address, tx *SafeTransaction) apitypes.TypedData {
+	return pk.NormalizeTypedData(apitypes.TypedData{
+		Types: apitypes.Types{
+			"SafeTx": []apitypes.Type{
+				{Name: "to", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "data", Type: "bytes"},
+				{Name: "operation", Type: "uint8"},
+				{Name: "safeTxGas", Type: "uint256"},
+				{Name: "baseGas", Type: "uint256"},
+				{Name: "gasPrice", Type: "uint256"},
+				{Name: "gasToken", Type: "address"},
+				{Name: "refundReceiver", Type: "address"},
+				{Name: "nonce", Type: "uint256"},
+			},
+		},
+		PrimaryType: "SafeTx",
+		Domain: apitypes.TypedDataDomain{
+			ChainId:           (*math.HexOrDecimal256)(chainID),
+			VerifyingContract: safeAddress.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"to":             tx.To.Hex(),
+			"value":          _zeroIfNil(tx.Value).String(),
+			"data":           hexutil.Encode(tx.Data),
+			"operation":      fmt.Sprintf("%d", tx.Operation),
+			"safeTxGas":      _zeroIfNil(tx.SafeTxGas).String(),
+			"baseGas":        _zeroIfNil(tx.BaseGas).String(),
+			"gasPrice":       _zeroIfNil(tx.GasPrice).String(),
+			"gasToken":       tx.GasToken.Hex(),
+			"refundReceiver": tx.RefundReceiver.Hex(),
+			"nonce":          _zeroIfNil(tx.Nonce).String(),
+		},
+	})
+}
+
+// SafeTransactionHash is the safeTxHash owners sign, equal to Safe.getTransactionHash.
+func SafeTransactionHash(chainID *big.Int, safeAddress common.Address, tx *SafeTransaction) (common.Hash, error) {
+	hash, _, err := pk.TypedDataHash(SafeTypedData(chainID, safeAddress, tx))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(hash), nil
+}
+
+// SafeSignTransaction collects the signatures of the configured signers in owners, keyed by owner.
+// Every signer must be an owner of the Safe.
+func (ec *EvmClient) SafeSignTransaction(ctx context.Context, safeAddress common.Address, tx *SafeTransaction, owners []common.Address) (map[common.Address][]byte, error) {
+	abiMethod := consts.EvmSafeMethodSignTransaction
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	signatures, err := ec._safeSignTransaction(ctx, safeAddress, tx, owners)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return signatures, nil
+}
+
+// SafePackSignatures concatenates signatures sorted by owner address, the order execTransaction checks.
+func SafePackSignatures(signatures map[common.Address][]byte) []byte {
+	owners := make([]common.Address, 0, len(signatures))
+	for owner := range signatures {
+		owners = append(owners, owner)
+	}
+	sort.Slice(owners, func(i, j int) bool {
+		return bytes.Compare(owners[i].Bytes(), owners[j].Bytes()) < 0
+	})
+	packed := make([]byte, 0, len(owners)*65)
+	for _, owner := range owners {
+		packed = append(packed, signatures[owner]...)
+	}
+	return packed
+}
+
+// SafeExecTransaction submits tx with already packed signatures, relayer pays the gas.
+func (ec *EvmClient) SafeExecTransaction(ctx context.Context, relayer common.Address, safeAddress common.Address, tx *SafeTransaction, signatures []byte) (*types.Transaction, error) {
+	abiMethod := consts.EvmSafeMethodExecTransaction
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	callResp, err := ec._safeExecTransaction(ctx, relayer, safeAddress, tx, signatures)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return callResp, nil
+}
+
+// SafeExecute signs tx with the configured signers in owners, checks them against the Safe owners and
+// threshold, and submits execTransaction through relayer.
+func (ec *EvmClient) SafeExecute(ctx context.Context, relayer common.Address, safeAddress common.Address, tx *SafeTransaction, owners []common.Address) (*types.Transaction, error) {
+	abiMethod := consts.EvmSafeMethodExecTransaction
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	threshold, err := ec.SafeGetThreshold(ctx, safeAddress)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	// Every owner signs once, repeating one does not add to the threshold.
+	distinct := make(map[common.Address]struct{}, len(owners))
+	for _, owner := range owners {
+		distinct[owner] = struct{}{}
+	}
+	if big.NewInt(int64(len(distinct))).Cmp(threshold) < 0 {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, fmt.Errorf("%w: %d of %s", ErrSafeThresholdNotMet, len(distinct), threshold)
+	}
+	signatures, err := ec._safeSignTransaction(ctx, safeAddress, tx, owners)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	callResp, err := ec._safeExecTransaction(ctx, relayer, safeAddress, tx, SafePackSignatures(signatures))
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return callResp, nil
+}
+
+// SafeMultiSendTransaction batches calls into one SafeTx delegate calling MultiSend. MultiSendCallOnly
+// is used unless a call is itself a delegate call.
+func SafeMultiSendTransaction(calls []*SafeMultiSendCall) (*SafeTransaction, error) {
+	multiSend := SafeMultiSendCallOnlyAddress
+	var transactions []byte
+	for _, call := range calls {
+		if call.Operation == SafeOperationDelegateCall {
+			multiSend = SafeMultiSendAddress
+		}
+		transactions = append(transactions, call.Operation)
+		transactions = append(transactions, call.To.Bytes()...)
+		transactions = append(transactions, common.LeftPadBytes(_zeroIfNil(call.Value).Bytes(), 32)...)
+		transactions = append(transactions, common.LeftPadBytes(big.NewInt(int64(len(call.Data))).Bytes(), 32)...)
+		transactions = append(transactions, call.Data...)
+	}
+	abi, err := safe.MultiSendMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	abiData, err := abi.Pack("multiSend", transactions)
+	if err != nil {
+		return nil, err
+	}
+	return &SafeTransaction{To: multiSend, Value: big.NewInt(0), Data: abiData, Operation: SafeOperationDelegateCall}, nil
+}
+
+func (ec *EvmClient) _safeSignTransaction(ctx context.Context, safeAddress common.Address, tx *SafeTransaction, owners []common.Address) (map[common.Address][]byte, error) {
+	if tx.Nonce == nil {
+		nonce, err := ec.SafeNonce(ctx, safeAddress)
+		if err != nil {
+			return nil, err
+		}
+		tx.Nonce = nonce
+	}
+	safeOwners, err := ec.SafeGetOwners(ctx, safeAddress)
+	if err != nil {
+		return nil, err
+	}
+	isOwner := make(map[common.Address]bool, len(safeOwners))
+	for _, owner := range safeOwners {
+		isOwner[owner] = true
+	}
+	chainID, err := ec.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedData := SafeTypedData(chainID, safeAddress, tx)
+	signatures := make(map[common.Address][]byte, len(owners))
+	for _, owner := range owners {
+		if !isOwner[owner] {
+			return nil, fmt.Errorf("%w: %s", ErrSafeNotOwner, owner.Hex())
+		}
+		signature, err := ec._signTypedData(ctx, owner, typedData)
+		if err != nil {
+			return nil, err
+		}
+		signatures[owner] = signature
+	}
+	return signatures, nil
+}
+
+func (ec *EvmClient) _safeExecTransaction(ctx context.Context, relayer common.Address, safeAddress common.Address, tx *SafeTransaction, signatures []byte) (*types.Transaction, error) {
+	inst, err := safe.NewSafe(safeAddress, ec._contractBackend())
+	if err != nil {
+		return nil, err
+	}
+	abi, err := safe.SafeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	value, safeTxGas, baseGas, gasPrice := _zeroIfNil(tx.Value), _zeroIfNil(tx.SafeTxGas), _zeroIfNil(tx.BaseGas), _zeroIfNil(tx.GasPrice)
+	abiData, err := abi.Pack("execTransaction", tx.To, value, tx.Data, tx.Operation, safeTxGas, baseGas, gasPrice, tx.GasToken, tx.RefundReceiver, signatures)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := ec._getTransactOpts(ctx, relayer, safeAddress, common.Bytes2Hex(abiData))
+	if err != nil {
+		return nil, err
+	}
+	return inst.ExecTransaction(opts, tx.To, value, tx.Data, tx.Operation, safeTxGas, baseGas, gasPrice, tx.GasToken, tx.RefundReceiver, signatures)
+}
+
+func _zeroIfNil(v *big.Int) *big.Int {
+	if v == nil {
+		return new(big.Int)
+	}
+	return v
+}
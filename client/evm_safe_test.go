@@ -0,0 +1,172 @@
+package client
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/6boris/web3-go/erc/safe"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/6boris/web3-go/pkg/pk"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// testSafeService is a 2 of 3 Safe at nonce 7 which records the execTransaction it receives.
+type testSafeService struct {
+	testTxService
+	safeAbi abi.ABI
+	owners  []common.Address
+}
+
+func (s *testSafeService) Call(args map[string]interface{}, block string) (hexutil.Bytes, error) {
+	_ = block
+	data := common.FromHex(args["input"].(string))
+	method, err := s.safeAbi.MethodById(data)
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "nonce":
+		return method.Outputs.Pack(big.NewInt(7))
+	case "getOwners":
+		return method.Outputs.Pack(s.owners)
+	case "getThreshold":
+		return method.Outputs.Pack(big.NewInt(2))
+	}
+	return nil, errors.New("unsupported call")
+}
+
+func TestEvmClient_Unite_Safe(t *testing.T) {
+	safeAbi, err := safe.SafeMetaData.GetAbi()
+	assert.Nil(t, err)
+	signers := make([]*clientModel.ConfEvmChainSigner, 0, 3)
+	for _, seed := range []string{"alice", "bob", "relayer"} {
+		signer, err := pk.TransformPkToEvmSigner(hex.EncodeToString(crypto.Keccak256([]byte(seed))))
+		assert.Nil(t, err)
+		signers = append(signers, signer)
+	}
+	alice, bob, relayer := signers[0].PublicAddress, signers[1].PublicAddress, signers[2].PublicAddress
+	service := &testSafeService{
+		testTxService: testTxService{gas: 100000},
+		safeAbi:       *safeAbi,
+		owners:        []common.Address{alice, bob, common.HexToAddress("0x3000000000000000000000000000000000000003")},
+	}
+	ec := _testEvmClient(t, map[string]interface{}{"eth": service}, &clientModel.ConfEvmChainClient{
+		GasFeeRate:   decimal.NewFromInt(1),
+		GasLimitRate: decimal.NewFromInt(1),
+		GasLimitMax:  decimal.NewFromInt(1000000),
+		Signers:      signers,
+	})
+	safeAddress := common.HexToAddress("0x5afe000000000000000000000000000000005afe")
+	to := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+	t.Run("Reads", func(t *testing.T) {
+		nonce, err := ec.SafeNonce(testCtx, safeAddress)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(7), nonce.Int64())
+		owners, err := ec.SafeGetOwners(testCtx, safeAddress)
+		assert.Nil(t, err)
+		assert.Equal(t, service.owners, owners)
+		threshold, err := ec.SafeGetThreshold(testCtx, safeAddress)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), threshold.Int64())
+	})
+	t.Run("SafeTransactionHash", func(t *testing.T) {
+		tx := &SafeTransaction{To: to, Value: big.NewInt(1e18), Data: common.FromHex("0xdeadbeef"), Nonce: big.NewInt(7)}
+		hash, err := SafeTransactionHash(big.NewInt(1), safeAddress, tx)
+		assert.Nil(t, err)
+
+		word := func(v []byte) []byte { return common.LeftPadBytes(v, 32) }
+		domainSeparator := crypto.Keccak256(
+			common.FromHex("0x47e79534a245952e8b16893a336b85a3d9ea9fa8c573f3d803afb92a79469218"),
+			word(big.NewInt(1).Bytes()), word(safeAddress.Bytes()),
+		)
+		structHash := crypto.Keccak256(
+			common.FromHex("0xbb8310d486368db6bd6f849402fdd73ad53d316b5a4b2644ad6efe0f941286d8"),
+			word(to.Bytes()), word(tx.Value.Bytes()), crypto.Keccak256(tx.Data), word(nil),
+			word(nil), word(nil), word(nil), word(nil), word(nil), word(big.NewInt(7).Bytes()),
+		)
+		expected := crypto.Keccak256Hash([]byte{0x19, 0x01}, domainSeparator, structHash)
+		assert.Equal(t, expected, hash)
+	})
+	t.Run("SafePackSignatures", func(t *testing.T) {
+		low, high := common.HexToAddress("0x01"), common.HexToAddress("0xff")
+		packed := SafePackSignatures(map[common.Address][]byte{high: bytes.Repeat([]byte{2}, 65), low: bytes.Repeat([]byte{1}, 65)})
+		assert.Equal(t, append(bytes.Repeat([]byte{1}, 65), bytes.Repeat([]byte{2}, 65)...), packed)
+	})
+	t.Run("SafeSignTransaction", func(t *testing.T) {
+		tx := &SafeTransaction{To: to, Value: big.NewInt(1)}
+		signatures, err := ec.SafeSignTransaction(testCtx, safeAddress, tx, []common.Address{alice, bob})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(7), tx.Nonce.Int64())
+		for _, owner := range []common.Address{alice, bob} {
+			recovered, err := pk.RecoverTypedDataSigner(SafeTypedData(big.NewInt(1), safeAddress, tx), signatures[owner])
+			assert.Nil(t, err)
+			assert.Equal(t, owner, recovered)
+		}
+		_, err = ec.SafeSignTransaction(testCtx, safeAddress, tx, []common.Address{relayer})
+		assert.ErrorIs(t, err, ErrSafeNotOwner)
+	})
+	t.Run("SafeExecute", func(t *testing.T) {
+		_, err := ec.SafeExecute(testCtx, relayer, safeAddress, &SafeTransaction{To: to}, []common.Address{alice})
+		assert.ErrorIs(t, err, ErrSafeThresholdNotMet)
+		sent := len(service.sent)
+		_, err = ec.SafeExecute(testCtx, relayer, safeAddress, &SafeTransaction{To: to}, []common.Address{alice, alice})
+		assert.ErrorIs(t, err, ErrSafeThresholdNotMet)
+		assert.Equal(t, sent, len(service.sent))
+
+		tx := &SafeTransaction{To: to, Value: big.NewInt(1)}
+		executed, err := ec.SafeExecute(testCtx, relayer, safeAddress, tx, []common.Address{bob, alice})
+		assert.Nil(t, err)
+		assert.Equal(t, executed.Hash(), service._lastSent().Hash())
+		assert.Equal(t, safeAddress, *service._lastSent().To())
+		sender, err := types.Sender(types.LatestSignerForChainID(big.NewInt(1)), service._lastSent())
+		assert.Nil(t, err)
+		assert.Equal(t, relayer, sender)
+
+		args, err := safeAbi.Methods["execTransaction"].Inputs.Unpack(service._lastSent().Data()[4:])
+		assert.Nil(t, err)
+		packed := args[9].([]byte)
+		assert.Equal(t, 130, len(packed))
+		hash, err := SafeTransactionHash(big.NewInt(1), safeAddress, tx)
+		assert.Nil(t, err)
+		var previous common.Address
+		for i := 0; i < 2; i++ {
+			signature := common.CopyBytes(packed[i*65 : (i+1)*65])
+			signature[64] -= 27
+			pub, err := crypto.SigToPub(hash.Bytes(), signature)
+			assert.Nil(t, err)
+			owner := crypto.PubkeyToAddress(*pub)
+			assert.True(t, bytes.Compare(previous.Bytes(), owner.Bytes()) < 0)
+			previous = owner
+		}
+	})
+	t.Run("SafeMultiSendTransaction", func(t *testing.T) {
+		tx, err := SafeMultiSendTransaction([]*SafeMultiSendCall{
+			{To: to, Value: big.NewInt(1)},
+			{To: to, Data: common.FromHex("0xabcd")},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, SafeMultiSendCallOnlyAddress, tx.To)
+		assert.Equal(t, SafeOperationDelegateCall, tx.Operation)
+		multiSendAbi, err := safe.MultiSendMetaData.GetAbi()
+		assert.Nil(t, err)
+		args, err := multiSendAbi.Methods["multiSend"].Inputs.Unpack(tx.Data[4:])
+		assert.Nil(t, err)
+		expected := "00" + hex.EncodeToString(to.Bytes()) + hex.EncodeToString(common.LeftPadBytes([]byte{1}, 32)) + hex.EncodeToString(make([]byte, 32)) +
+			"00" + hex.EncodeToString(to.Bytes()) + hex.EncodeToString(make([]byte, 32)) + hex.EncodeToString(common.LeftPadBytes([]byte{2}, 32)) + "abcd"
+		assert.Equal(t, expected, hex.EncodeToString(args[0].([]byte)))
+
+		tx, err = SafeMultiSendTransaction([]*SafeMultiSendCall{{Operation: SafeOperationDelegateCall, To: to}})
+		assert.Nil(t, err)
+		assert.Equal(t, SafeMultiSendAddress, tx.To)
+	})
+}
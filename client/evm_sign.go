@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+
+	"github.com/6boris/web3-go/consts"
+	"github.com/6boris/web3-go/erc/erc1271"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/6boris/web3-go/pkg/pk"
+	web3Signer "github.com/6boris/web3-go/pkg/signer"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// erc1271MagicValue is bytes4(keccak256("isValidSignature(bytes32,bytes)")).
+var erc1271MagicValue = [4]byte{0x16, 0x26, 0xba, 0x7e}
+
+func (ec *EvmClient) PersonalSign(ctx context.Context, signer common.Address, message []byte) ([]byte, error) {
+	abiMethod := consts.EvmMethodPersonalSign
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	msgSigner, err := ec._getSigner(signer)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	var signature []byte
+	if textSigner, ok := msgSigner.(web3Signer.TextSigner); ok {
+		signature, err = textSigner.SignText(ctx, message)
+	} else {
+		signature, err = msgSigner.SignHash(ctx, pk.PersonalMessageHash(message))
+	}
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+	}
+	return signature, err
+}
+func (ec *EvmClient) SignTypedData(ctx context.Context, signer common.Address, typedData apitypes.TypedData) ([]byte, error) {
+	abiMethod := consts.EvmMethodSignTypedData
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	msgSigner, err := ec._getSigner(signer)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	signature, err := msgSigner.SignTypedData(ctx, typedData)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+	}
+	return signature, err
+}
+
+// VerifyPersonalSign reports whether account signed message with personal_sign.
+// Contract wallets are verified through EIP-1271.
+func (ec *EvmClient) VerifyPersonalSign(ctx context.Context, account common.Address, message []byte, signature []byte) (bool, error) {
+	return ec._verifyHashSignature(ctx, account, pk.PersonalMessageHash(message), signature)
+}
+
+// VerifyTypedData reports whether account signed typedData with eth_signTypedData_v4.
+// Contract wallets are verified through EIP-1271.
+func (ec *EvmClient) VerifyTypedData(ctx context.Context, account common.Address, typedData apitypes.TypedData, signature []byte) (bool, error) {
+	hash, _, err := pk.TypedDataHash(typedData)
+	if err != nil {
+		return false, err
+	}
+	return ec._verifyHashSignature(ctx, account, hash, signature)
+}
+func (ec *EvmClient) ERC1271IsValidSignature(ctx context.Context, account common.Address, hash common.Hash, signature []byte) (bool, error) {
+	return ec.ERC1271IsValidSignatureWithBlock(ctx, account, hash, signature, BlockRef{})
+}
+func (ec *EvmClient) ERC1271IsValidSignatureWithBlock(ctx context.Context, account common.Address, hash common.Hash, signature []byte, block BlockRef) (bool, error) {
+	abiMethod := consts.EvmErc1271MethodIsValidSignature
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	inst, err := erc1271.NewERC1271(account, ec.ethClient)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return false, err
+	}
+	opts, err := ec._getCallOpts(ctx, block)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return false, err
+	}
+	callResp, err := inst.IsValidSignature(opts, hash, signature)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return false, err
+	}
+	return callResp == erc1271MagicValue, nil
+}
+
+func (ec *EvmClient) _verifyHashSignature(ctx context.Context, account common.Address, hash []byte, signature []byte) (bool, error) {
+	abiMethod := consts.EvmMethodVerifySignature
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	recovered, err := pk.RecoverHashSigner(hash, signature)
+	if err == nil && recovered == account {
+		return true, nil
+	}
+	code, err := ec.ethClient.CodeAt(ctx, account, nil)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return false, err
+	}
+	if len(code) == 0 {
+		return false, nil
+	}
+	return ec.ERC1271IsValidSignature(ctx, account, common.BytesToHash(hash), signature)
+}
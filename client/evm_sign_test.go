@@ -0,0 +1,90 @@
+package client
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/6boris/web3-go/pkg/pk"
+	"github.com/6boris/web3-go/pkg/policy"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvmClient_Unite_Sign(t *testing.T) {
+	// EIP-712 reference example: https://eips.ethereum.org/EIPS/eip-712
+	signer, err := pk.TransformPkToEvmSigner(hex.EncodeToString(crypto.Keccak256([]byte("cow"))))
+	assert.Nil(t, err)
+	ec, err := NewEvmClient(&clientModel.ConfEvmChainClient{
+		TransportURL: "https://1rpc.io/eth",
+		Signers:      []*clientModel.ConfEvmChainSigner{signer},
+	})
+	assert.Nil(t, err)
+	mail := apitypes.TypedData{
+		Types: apitypes.Types{
+			"Person": []apitypes.Type{{Name: "name", Type: "string"}, {Name: "wallet", Type: "address"}},
+			"Mail":   []apitypes.Type{{Name: "from", Type: "Person"}, {Name: "to", Type: "Person"}, {Name: "contents", Type: "string"}},
+		},
+		PrimaryType: "Mail",
+		Domain: apitypes.TypedDataDomain{
+			Name:              "Ether Mail",
+			Version:           "1",
+			ChainId:           math.NewHexOrDecimal256(1),
+			VerifyingContract: "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+		},
+		Message: apitypes.TypedDataMessage{
+			"from":     map[string]interface{}{"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+			"to":       map[string]interface{}{"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+			"contents": "Hello, Bob!",
+		},
+	}
+	t.Run("TypedDataHash", func(t *testing.T) {
+		hash, domainSeparator, err := pk.TypedDataHash(mail)
+		assert.Nil(t, err)
+		assert.Equal(t, "0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", common.BytesToHash(hash).Hex())
+		assert.Equal(t, "0xf2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f", common.BytesToHash(domainSeparator).Hex())
+	})
+	t.Run("SignTypedData", func(t *testing.T) {
+		signature, err := ec.SignTypedData(testCtx, signer.PublicAddress, mail)
+		assert.Nil(t, err)
+		assert.Equal(t, "4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d", hex.EncodeToString(signature[:32]))
+		assert.Equal(t, "07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b91562", hex.EncodeToString(signature[32:64]))
+		assert.Equal(t, byte(28), signature[64])
+
+		recovered, err := pk.RecoverTypedDataSigner(mail, signature)
+		assert.Nil(t, err)
+		assert.Equal(t, signer.PublicAddress, recovered)
+		ok, err := ec.VerifyTypedData(testCtx, signer.PublicAddress, mail, signature)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+	})
+	t.Run("PersonalSign", func(t *testing.T) {
+		message := []byte("web3-go personal_sign")
+		signature, err := ec.PersonalSign(testCtx, signer.PublicAddress, message)
+		assert.Nil(t, err)
+		recovered, err := pk.RecoverPersonalSigner(message, signature)
+		assert.Nil(t, err)
+		assert.Equal(t, signer.PublicAddress, recovered)
+		ok, err := ec.VerifyPersonalSign(testCtx, signer.PublicAddress, message, signature)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+	})
+	t.Run("Policy", func(t *testing.T) {
+		policySigner := *signer
+		policySigner.Policy = &clientModel.ConfEvmSignerPolicy{RecipientAllowlist: []common.Address{signer.PublicAddress}}
+		policyClient := _testEvmClient(t, map[string]interface{}{"eth": &testTxService{}}, &clientModel.ConfEvmChainClient{
+			Signers: []*clientModel.ConfEvmChainSigner{&policySigner},
+		})
+		userOpHash := crypto.Keccak256([]byte("web3-go user operation"))
+		_, err := policyClient.PersonalSign(testCtx, signer.PublicAddress, userOpHash)
+		assert.ErrorIs(t, err, policy.ErrDenied)
+	})
+	t.Run("SignerNotConfig", func(t *testing.T) {
+		_, err := ec.PersonalSign(testCtx, common.BigToAddress(big.NewInt(1)), []byte("web3-go"))
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,222 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/6boris/web3-go/consts"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// StateOverride replaces balance, nonce, code or storage of accounts for the duration of a call.
+type StateOverride map[common.Address]OverrideAccount
+
+// OverrideAccount is the overridden state of one account. State replaces the whole storage,
+// StateDiff only the given slots; a zero Nonce is not applied.
+type OverrideAccount struct {
+	Nonce     uint64
+	Code      []byte
+	Balance   *big.Int
+	State     map[common.Hash]common.Hash
+	StateDiff map[common.Hash]common.Hash
+}
+
+func (a OverrideAccount) MarshalJSON() ([]byte, error) {
+	type account struct {
+		Nonce     hexutil.Uint64              `json:"nonce,omitempty"`
+		Code      hexutil.Bytes               `json:"code,omitempty"`
+		Balance   *hexutil.Big                `json:"balance,omitempty"`
+		State     map[common.Hash]common.Hash `json:"state,omitempty"`
+		StateDiff map[common.Hash]common.Hash `json:"stateDiff,omitempty"`
+	}
+	return json.Marshal(&account{
+		Nonce:     hexutil.Uint64(a.Nonce),
+		Code:      a.Code,
+		Balance:   (*hexutil.Big)(a.Balance),
+		State:     a.State,
+		StateDiff: a.StateDiff,
+	})
+}
+
+// SimulateResult is the outcome of Simulate. GasUsed and Logs are only known when the node
+// supports eth_simulateV1, see Simulated.
+type SimulateResult struct {
+	ReturnData   []byte
+	GasUsed      uint64
+	Reverted     bool
+	RevertReason string
+	RevertData   []byte
+	Logs         []*types.Log
+	Simulated    bool
+}
+
+type simulateCallError struct {
+	Code    int           `json:"code"`
+	Message string        `json:"message"`
+	Data    hexutil.Bytes `json:"data"`
+}
+type simulateCallResult struct {
+	ReturnData hexutil.Bytes      `json:"returnData"`
+	Logs       []*types.Log       `json:"logs"`
+	GasUsed    hexutil.Uint64     `json:"gasUsed"`
+	Status     hexutil.Uint64     `json:"status"`
+	Error      *simulateCallError `json:"error"`
+}
+type simulateBlockResult struct {
+	Calls []simulateCallResult `json:"calls"`
+}
+
+// Simulate executes msg against block (nil for latest) with overrides applied, without broadcasting.
+// A revert is reported in the result rather than as error. eth_simulateV1 is used when the node
+// supports it, otherwise eth_call.
+func (ec *EvmClient) Simulate(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int, overrides StateOverride) (*SimulateResult, error) {
+	abiMethod := consts.EvmMethodSimulate
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	result, err := ec._simulateV1(ctx, msg, blockNumber, overrides)
+	if _isMethodNotFound(err, "eth_simulateV1") {
+		result, err = ec._simulateCall(ctx, msg, blockNumber, overrides)
+	}
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return result, nil
+}
+
+func (ec *EvmClient) _simulateV1(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int, overrides StateOverride) (*SimulateResult, error) {
+	blockStateCall := map[string]interface{}{
+		"calls": []interface{}{_toCallArg(msg)},
+	}
+	if len(overrides) > 0 {
+		blockStateCall["stateOverrides"] = overrides
+	}
+	opts := map[string]interface{}{
+		"blockStateCalls": []interface{}{blockStateCall},
+		"validation":      false,
+	}
+	var blocks []simulateBlockResult
+	err := ec.rpcClient.CallContext(ctx, &blocks, "eth_simulateV1", opts, _toBlockNumArg(blockNumber))
+	if err != nil {
+		return nil, err
+	}
+	if len(blocks) != 1 || len(blocks[0].Calls) != 1 {
+		return nil, errors.New("eth_simulateV1: unexpected result")
+	}
+	call := blocks[0].Calls[0]
+	result := &SimulateResult{
+		ReturnData: call.ReturnData,
+		GasUsed:    uint64(call.GasUsed),
+		Logs:       call.Logs,
+		Simulated:  true,
+	}
+	if uint64(call.Status) != types.ReceiptStatusSuccessful {
+		result.Reverted = true
+		if call.Error != nil {
+			result.RevertData = call.Error.Data
+			result.RevertReason = call.Error.Message
+		}
+		if len(result.RevertData) == 0 {
+			result.RevertData = call.ReturnData
+		}
+		if reason, err := abi.UnpackRevert(result.RevertData); err == nil {
+			result.RevertReason = reason
+		}
+	}
+	return result, nil
+}
+
+func (ec *EvmClient) _simulateCall(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int, overrides StateOverride) (*SimulateResult, error) {
+	var output hexutil.Bytes
+	var err error
+	if len(overrides) > 0 {
+		err = ec.rpcClient.CallContext(ctx, &output, "eth_call", _toCallArg(msg), _toBlockNumArg(blockNumber), overrides)
+	} else {
+		err = ec.rpcClient.CallContext(ctx, &output, "eth_call", _toCallArg(msg), _toBlockNumArg(blockNumber))
+	}
+	if err == nil {
+		return &SimulateResult{ReturnData: output}, nil
+	}
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return nil, err
+	}
+	errData, _ := dataErr.ErrorData().(string)
+	result := &SimulateResult{Reverted: true, RevertReason: err.Error(), RevertData: common.FromHex(errData)}
+	if reason, err := abi.UnpackRevert(result.RevertData); err == nil {
+		result.RevertReason = reason
+	}
+	return result, nil
+}
+
+// _isMethodNotFound reports whether err means the node does not implement method. Besides code -32601
+// only messages naming the method count, "header not found" and the like are real failures.
+func _isMethodNotFound(err error, method string) bool {
+	if err == nil {
+		return false
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == -32601 {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	if strings.Contains(msg, "method not found") {
+		return true
+	}
+	if !strings.Contains(msg, strings.ToLower(method)) {
+		return false
+	}
+	return strings.Contains(msg, "does not exist") || strings.Contains(msg, "not available") ||
+		strings.Contains(msg, "not supported") || strings.Contains(msg, "unsupported")
+}
+
+func _toBlockNumArg(number *big.Int) string {
+	if number == nil {
+		return "latest"
+	}
+	if number.Sign() >= 0 {
+		return hexutil.EncodeBig(number)
+	}
+	return rpc.BlockNumber(number.Int64()).String()
+}
+
+func _toCallArg(msg ethereum.CallMsg) map[string]interface{} {
+	arg := map[string]interface{}{
+		"from": msg.From,
+		"to":   msg.To,
+	}
+	if len(msg.Data) > 0 {
+		arg["input"] = hexutil.Bytes(msg.Data)
+	}
+	if msg.Value != nil {
+		arg["value"] = (*hexutil.Big)(msg.Value)
+	}
+	if msg.Gas != 0 {
+		arg["gas"] = hexutil.Uint64(msg.Gas)
+	}
+	if msg.GasPrice != nil {
+		arg["gasPrice"] = (*hexutil.Big)(msg.GasPrice)
+	}
+	if msg.GasFeeCap != nil {
+		arg["maxFeePerGas"] = (*hexutil.Big)(msg.GasFeeCap)
+	}
+	if msg.GasTipCap != nil {
+		arg["maxPriorityFeePerGas"] = (*hexutil.Big)(msg.GasTipCap)
+	}
+	if msg.AccessList != nil {
+		arg["accessList"] = msg.AccessList
+	}
+	return arg
+}
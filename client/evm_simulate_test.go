@@ -0,0 +1,118 @@
+package client
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+var testRevertInput = []byte{0xde, 0xad, 0xbe, 0xef}
+
+// testCallService answers eth_call with the overridden balance of the caller, or reverts.
+type testCallService struct{}
+
+func (s *testCallService) Call(args map[string]interface{}, block string, overrides *map[common.Address]map[string]interface{}) (hexutil.Bytes, error) {
+	_ = block
+	input, _ := args["input"].(string)
+	if input == hexutil.Encode(testRevertInput) {
+		return nil, &testRevertError{data: testRevertReason("insufficient balance")}
+	}
+	balance := new(big.Int)
+	if overrides != nil {
+		if account, ok := (*overrides)[common.HexToAddress(args["from"].(string))]; ok {
+			balance = hexutil.MustDecodeBig(account["balance"].(string))
+		}
+	}
+	return common.LeftPadBytes(balance.Bytes(), 32), nil
+}
+
+// testSimulateService additionally implements eth_simulateV1.
+type testSimulateService struct {
+	testCallService
+}
+
+func (s *testSimulateService) SimulateV1(opts map[string]interface{}, block string) ([]map[string]interface{}, error) {
+	_ = block
+	blockCall := opts["blockStateCalls"].([]interface{})[0].(map[string]interface{})
+	args := blockCall["calls"].([]interface{})[0].(map[string]interface{})
+	call := map[string]interface{}{"gasUsed": "0x5208", "logs": []interface{}{}}
+	if input, _ := args["input"].(string); input == hexutil.Encode(testRevertInput) {
+		call["status"] = "0x0"
+		call["returnData"] = hexutil.Encode(testRevertReason("insufficient balance"))
+		call["error"] = map[string]interface{}{"code": 3, "message": "execution reverted"}
+		return []map[string]interface{}{{"calls": []interface{}{call}}}, nil
+	}
+	balance := "0x0"
+	if overrides, ok := blockCall["stateOverrides"].(map[string]interface{}); ok {
+		for address, account := range overrides {
+			if common.HexToAddress(address) == common.HexToAddress(args["from"].(string)) {
+				balance = account.(map[string]interface{})["balance"].(string)
+			}
+		}
+	}
+	call["status"] = "0x1"
+	call["returnData"] = hexutil.Encode(common.LeftPadBytes(hexutil.MustDecodeBig(balance).Bytes(), 32))
+	call["logs"] = []interface{}{map[string]interface{}{
+		"address":          args["to"],
+		"topics":           []string{crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")).Hex()},
+		"data":             "0x",
+		"blockNumber":      "0x1",
+		"transactionHash":  common.Hash{}.Hex(),
+		"transactionIndex": "0x0",
+		"blockHash":        common.Hash{}.Hex(),
+		"logIndex":         "0x0",
+		"removed":          false,
+	}}
+	return []map[string]interface{}{{"calls": []interface{}{call}}}, nil
+}
+
+func testRevertReason(reason string) []byte {
+	data, err := abi.Arguments{{Type: mustType("string")}}.Pack(reason)
+	if err != nil {
+		panic(err)
+	}
+	return append(crypto.Keccak256([]byte("Error(string)"))[:4], data...)
+}
+
+func TestEvmClient_Unite_Simulate(t *testing.T) {
+	from := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	to := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	overrides := StateOverride{from: OverrideAccount{Balance: big.NewInt(1e18)}}
+	for name, service := range map[string]interface{}{"SimulateV1": &testSimulateService{}, "CallFallback": &testCallService{}} {
+		t.Run(name, func(t *testing.T) {
+			ec := _testEvmClient(t, map[string]interface{}{"eth": service}, nil)
+			simulated := name == "SimulateV1"
+
+			result, err := ec.Simulate(testCtx, ethereum.CallMsg{From: from, To: &to, Data: []byte{0x01}}, nil, overrides)
+			assert.Nil(t, err)
+			assert.False(t, result.Reverted)
+			assert.Equal(t, simulated, result.Simulated)
+			assert.Equal(t, int64(1e18), new(big.Int).SetBytes(result.ReturnData).Int64())
+			if simulated {
+				assert.Equal(t, uint64(21000), result.GasUsed)
+				assert.Len(t, result.Logs, 1)
+			}
+
+			result, err = ec.Simulate(testCtx, ethereum.CallMsg{From: from, To: &to, Data: testRevertInput}, big.NewInt(1), nil)
+			assert.Nil(t, err)
+			assert.True(t, result.Reverted)
+			assert.Equal(t, "insufficient balance", result.RevertReason)
+			assert.Equal(t, testRevertReason("insufficient balance"), result.RevertData)
+		})
+	}
+	t.Run("MethodNotFound", func(t *testing.T) {
+		assert.True(t, _isMethodNotFound(errors.New("the method eth_simulateV1 does not exist/is not available"), "eth_simulateV1"))
+		assert.True(t, _isMethodNotFound(errors.New("Method not found"), "eth_simulateV1"))
+		assert.True(t, _isMethodNotFound(errors.New("unsupported method: eth_simulateV1"), "eth_simulateV1"))
+		assert.False(t, _isMethodNotFound(errors.New("header not found"), "eth_simulateV1"))
+		assert.False(t, _isMethodNotFound(errors.New("account does not exist"), "eth_simulateV1"))
+		assert.False(t, _isMethodNotFound(nil, "eth_simulateV1"))
+	})
+}
@@ -0,0 +1,252 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"github.com/6boris/web3-go/consts"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	TracerCall     = "callTracer"
+	TracerPrestate = "prestateTracer"
+)
+
+// TraceConfig selects the tracer of TraceTransaction and TraceCall, nil traces with callTracer.
+type TraceConfig struct {
+	Tracer string
+	// OnlyTopCall and WithLog configure callTracer.
+	OnlyTopCall bool
+	WithLog     bool
+	// DiffMode configures prestateTracer to return the state before and after execution.
+	DiffMode bool
+	Timeout  string
+	// StateOverrides only apply to TraceCall.
+	StateOverrides StateOverride
+}
+
+// TraceResult holds Call for callTracer, Pre (and Post in diff mode) for prestateTracer.
+type TraceResult struct {
+	Call *CallFrame
+	Pre  map[common.Address]*PrestateAccount
+	Post map[common.Address]*PrestateAccount
+}
+
+type CallLog struct {
+	Address common.Address `json:"address"`
+	Topics  []common.Hash  `json:"topics"`
+	Data    hexutil.Bytes  `json:"data"`
+}
+
+// CallFrame is one call of the callTracer tree. Value is nil for STATICCALL and DELEGATECALL frames.
+type CallFrame struct {
+	Type         string
+	From         common.Address
+	To           *common.Address
+	Value        *big.Int
+	Gas          uint64
+	GasUsed      uint64
+	Input        []byte
+	Output       []byte
+	Error        string
+	RevertReason string
+	Logs         []CallLog
+	Calls        []*CallFrame
+}
+
+func (f *CallFrame) UnmarshalJSON(input []byte) error {
+	type frame struct {
+		Type         string          `json:"type"`
+		From         common.Address  `json:"from"`
+		To           *common.Address `json:"to"`
+		Value        *hexutil.Big    `json:"value"`
+		Gas          hexutil.Uint64  `json:"gas"`
+		GasUsed      hexutil.Uint64  `json:"gasUsed"`
+		Input        hexutil.Bytes   `json:"input"`
+		Output       hexutil.Bytes   `json:"output"`
+		Error        string          `json:"error"`
+		RevertReason string          `json:"revertReason"`
+		Logs         []CallLog       `json:"logs"`
+		Calls        []*CallFrame    `json:"calls"`
+	}
+	var dec frame
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	*f = CallFrame{
+		Type:         dec.Type,
+		From:         dec.From,
+		To:           dec.To,
+		Value:        (*big.Int)(dec.Value),
+		Gas:          uint64(dec.Gas),
+		GasUsed:      uint64(dec.GasUsed),
+		Input:        dec.Input,
+		Output:       dec.Output,
+		Error:        dec.Error,
+		RevertReason: dec.RevertReason,
+		Logs:         dec.Logs,
+		Calls:        dec.Calls,
+	}
+	if f.Error != "" && f.RevertReason == "" {
+		if reason, err := abi.UnpackRevert(f.Output); err == nil {
+			f.RevertReason = reason
+		}
+	}
+	return nil
+}
+
+type PrestateAccount struct {
+	Balance *big.Int
+	Nonce   uint64
+	Code    []byte
+	Storage map[common.Hash]common.Hash
+}
+
+func (a *PrestateAccount) UnmarshalJSON(input []byte) error {
+	type account struct {
+		Balance *hexutil.Big                `json:"balance"`
+		Nonce   uint64                      `json:"nonce"`
+		Code    hexutil.Bytes               `json:"code"`
+		Storage map[common.Hash]common.Hash `json:"storage"`
+	}
+	var dec account
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	*a = PrestateAccount{Balance: (*big.Int)(dec.Balance), Nonce: dec.Nonce, Code: dec.Code, Storage: dec.Storage}
+	return nil
+}
+
+// InternalTransfer is an ETH transfer made by a contract during execution.
+type InternalTransfer struct {
+	Type  string
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Depth int
+}
+
+// TraceTransaction replays a mined transaction with debug_traceTransaction.
+func (ec *EvmClient) TraceTransaction(ctx context.Context, txHash common.Hash, config *TraceConfig) (*TraceResult, error) {
+	abiMethod := consts.EvmMethodTraceTransaction
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	var raw json.RawMessage
+	err := ec.rpcClient.CallContext(ctx, &raw, "debug_traceTransaction", txHash, _toTraceConfigArg(config, false))
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	result, err := _decodeTraceResult(raw, config)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return result, nil
+}
+
+// TraceCall traces msg on top of block (nil for latest) with debug_traceCall.
+func (ec *EvmClient) TraceCall(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int, config *TraceConfig) (*TraceResult, error) {
+	abiMethod := consts.EvmMethodTraceCall
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	var raw json.RawMessage
+	err := ec.rpcClient.CallContext(ctx, &raw, "debug_traceCall", _toCallArg(msg), _toBlockNumArg(blockNumber), _toTraceConfigArg(config, true))
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	result, err := _decodeTraceResult(raw, config)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return result, nil
+}
+
+// InternalTransfers walks the call tree below root and returns every call, create and selfdestruct
+// that moved ETH, in execution order. Reverted frames and everything below them are skipped, as is
+// root itself whose value is the transaction value. A CALLCODE runs in the caller's account, its value
+// never leaves it.
+func InternalTransfers(root *CallFrame) []*InternalTransfer {
+	transfers := make([]*InternalTransfer, 0)
+	if root == nil || root.Error != "" {
+		return transfers
+	}
+	var walk func(frame *CallFrame, depth int)
+	walk = func(frame *CallFrame, depth int) {
+		for _, call := range frame.Calls {
+			if call.Error != "" {
+				continue
+			}
+			switch call.Type {
+			case "CALL", "CREATE", "CREATE2", "SELFDESTRUCT":
+				if call.Value != nil && call.Value.Sign() > 0 && call.To != nil {
+					transfers = append(transfers, &InternalTransfer{
+						Type:  call.Type,
+						From:  call.From,
+						To:    *call.To,
+						Value: call.Value,
+						Depth: depth,
+					})
+				}
+			}
+			walk(call, depth+1)
+		}
+	}
+	walk(root, 1)
+	return transfers
+}
+
+func _toTraceConfigArg(config *TraceConfig, withOverrides bool) map[string]interface{} {
+	if config == nil {
+		config = &TraceConfig{}
+	}
+	arg := map[string]interface{}{}
+	switch config.Tracer {
+	case TracerPrestate:
+		arg["tracer"] = TracerPrestate
+		arg["tracerConfig"] = map[string]interface{}{"diffMode": config.DiffMode}
+	default:
+		arg["tracer"] = TracerCall
+		arg["tracerConfig"] = map[string]interface{}{"onlyTopCall": config.OnlyTopCall, "withLog": config.WithLog}
+	}
+	if config.Timeout != "" {
+		arg["timeout"] = config.Timeout
+	}
+	if withOverrides && len(config.StateOverrides) > 0 {
+		arg["stateOverrides"] = config.StateOverrides
+	}
+	return arg
+}
+
+func _decodeTraceResult(raw json.RawMessage, config *TraceConfig) (*TraceResult, error) {
+	result := &TraceResult{}
+	if config == nil || config.Tracer != TracerPrestate {
+		return result, json.Unmarshal(raw, &result.Call)
+	}
+	if !config.DiffMode {
+		return result, json.Unmarshal(raw, &result.Pre)
+	}
+	diff := struct {
+		Pre  map[common.Address]*PrestateAccount `json:"pre"`
+		Post map[common.Address]*PrestateAccount `json:"post"`
+	}{}
+	if err := json.Unmarshal(raw, &diff); err != nil {
+		return nil, err
+	}
+	result.Pre, result.Post = diff.Pre, diff.Post
+	return result, nil
+}
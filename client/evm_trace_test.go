@@ -0,0 +1,104 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+const testCallTrace = `{
+  "type": "CALL", "from": "0x1000000000000000000000000000000000000001", "to": "0x2000000000000000000000000000000000000002",
+  "value": "0xde0b6b3a7640000", "gas": "0x30d40", "gasUsed": "0x1d4c0", "input": "0x12345678", "output": "0x",
+  "calls": [
+    {"type": "CALL", "from": "0x2000000000000000000000000000000000000002", "to": "0x3000000000000000000000000000000000000003", "value": "0x58d15e176280000", "gas": "0x1", "gasUsed": "0x1", "input": "0x"},
+    {"type": "DELEGATECALL", "from": "0x2000000000000000000000000000000000000002", "to": "0x4000000000000000000000000000000000000004", "value": "0x58d15e176280000", "gas": "0x1", "gasUsed": "0x1", "input": "0x",
+     "calls": [{"type": "CALL", "from": "0x2000000000000000000000000000000000000002", "to": "0x5000000000000000000000000000000000000005", "value": "0x2c68af0bb140000", "gas": "0x1", "gasUsed": "0x1", "input": "0x"}]},
+    {"type": "CALL", "from": "0x2000000000000000000000000000000000000002", "to": "0x6000000000000000000000000000000000000006", "value": "0x16345785d8a0000", "gas": "0x1", "gasUsed": "0x1", "input": "0x",
+     "error": "execution reverted", "output": "0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000b6e6f7420616c6c6f776564000000000000000000000000000000000000000000",
+     "calls": [{"type": "CALL", "from": "0x6000000000000000000000000000000000000006", "to": "0x7000000000000000000000000000000000000007", "value": "0x1", "gas": "0x1", "gasUsed": "0x1", "input": "0x"}]},
+    {"type": "STATICCALL", "from": "0x2000000000000000000000000000000000000002", "to": "0x3000000000000000000000000000000000000003", "gas": "0x1", "gasUsed": "0x1", "input": "0x"},
+    {"type": "CALLCODE", "from": "0x2000000000000000000000000000000000000002", "to": "0x9000000000000000000000000000000000000009", "value": "0x16345785d8a0000", "gas": "0x1", "gasUsed": "0x1", "input": "0x"},
+    {"type": "SELFDESTRUCT", "from": "0x2000000000000000000000000000000000000002", "to": "0x8000000000000000000000000000000000000008", "value": "0x429d069189e0000", "gas": "0x0", "gasUsed": "0x0", "input": "0x"}
+  ]
+}`
+
+const testPrestateDiffTrace = `{
+  "pre": {"0x1000000000000000000000000000000000000001": {"balance": "0xde0b6b3a7640000", "nonce": 1}},
+  "post": {"0x1000000000000000000000000000000000000001": {"balance": "0x0", "nonce": 2},
+           "0x2000000000000000000000000000000000000002": {"code": "0x6001", "storage": {"0x0000000000000000000000000000000000000000000000000000000000000000": "0x0000000000000000000000000000000000000000000000000000000000000001"}}}
+}`
+
+type testDebugService struct{}
+
+func (s *testDebugService) trace(config map[string]interface{}) (json.RawMessage, error) {
+	switch config["tracer"] {
+	case TracerCall:
+		return json.RawMessage(testCallTrace), nil
+	case TracerPrestate:
+		if config["tracerConfig"].(map[string]interface{})["diffMode"] == true {
+			return json.RawMessage(testPrestateDiffTrace), nil
+		}
+		return json.RawMessage(`{"0x1000000000000000000000000000000000000001": {"balance": "0x1", "nonce": 7}}`), nil
+	}
+	return nil, errors.New("unknown tracer")
+}
+
+func (s *testDebugService) TraceTransaction(hash common.Hash, config map[string]interface{}) (json.RawMessage, error) {
+	_ = hash
+	return s.trace(config)
+}
+
+func (s *testDebugService) TraceCall(args map[string]interface{}, block string, config map[string]interface{}) (json.RawMessage, error) {
+	_, _ = args, block
+	if _, ok := config["stateOverrides"]; !ok {
+		return nil, errors.New("state overrides missing")
+	}
+	return s.trace(config)
+}
+
+func TestEvmClient_Unite_Trace(t *testing.T) {
+	ec := _testEvmClient(t, map[string]interface{}{"debug": &testDebugService{}}, nil)
+
+	t.Run("TraceTransaction", func(t *testing.T) {
+		result, err := ec.TraceTransaction(testCtx, common.HexToHash("0x01"), nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "CALL", result.Call.Type)
+		assert.Equal(t, uint64(120000), result.Call.GasUsed)
+		assert.Len(t, result.Call.Calls, 6)
+		assert.Nil(t, result.Call.Calls[3].Value)
+		assert.Equal(t, "not allowed", result.Call.Calls[2].RevertReason)
+	})
+	t.Run("TraceCallPrestate", func(t *testing.T) {
+		msg := ethereum.CallMsg{To: &common.Address{}}
+		config := &TraceConfig{Tracer: TracerPrestate, StateOverrides: StateOverride{common.Address{}: {Nonce: 1}}}
+		result, err := ec.TraceCall(testCtx, msg, nil, config)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(7), result.Pre[common.HexToAddress("0x1000000000000000000000000000000000000001")].Nonce)
+		assert.Nil(t, result.Post)
+
+		config.DiffMode = true
+		result, err = ec.TraceCall(testCtx, msg, big.NewInt(100), config)
+		assert.Nil(t, err)
+		post := result.Post[common.HexToAddress("0x2000000000000000000000000000000000000002")]
+		assert.Equal(t, []byte{0x60, 0x01}, post.Code)
+		assert.Equal(t, common.BigToHash(big.NewInt(1)), post.Storage[common.Hash{}])
+		assert.Equal(t, int64(1e18), result.Pre[common.HexToAddress("0x1000000000000000000000000000000000000001")].Balance.Int64())
+	})
+	t.Run("InternalTransfers", func(t *testing.T) {
+		result, err := ec.TraceTransaction(testCtx, common.HexToHash("0x01"), &TraceConfig{Tracer: TracerCall})
+		assert.Nil(t, err)
+		transfers := InternalTransfers(result.Call)
+		assert.Len(t, transfers, 3)
+		assert.Equal(t, common.HexToAddress("0x3000000000000000000000000000000000000003"), transfers[0].To)
+		assert.Equal(t, int64(4e17), transfers[0].Value.Int64())
+		assert.Equal(t, common.HexToAddress("0x5000000000000000000000000000000000000005"), transfers[1].To)
+		assert.Equal(t, 2, transfers[1].Depth)
+		assert.Equal(t, "SELFDESTRUCT", transfers[2].Type)
+		assert.Equal(t, int64(3e17), transfers[2].Value.Int64())
+	})
+}
@@ -0,0 +1,246 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/6boris/web3-go/consts"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	TxPoolIssueNonceGap    = "nonce_gap"
+	TxPoolIssueUnderpriced = "underpriced"
+)
+
+// TxPoolContent groups the transactions of the pool by sender and nonce. Queued transactions are not
+// executable yet, usually because a lower nonce is missing.
+type TxPoolContent struct {
+	Pending map[common.Address]map[uint64]*types.Transaction `json:"pending"`
+	Queued  map[common.Address]map[uint64]*types.Transaction `json:"queued"`
+}
+
+type TxPoolAccountContent struct {
+	Pending map[uint64]*types.Transaction `json:"pending"`
+	Queued  map[uint64]*types.Transaction `json:"queued"`
+}
+
+// TxPoolInspect is the text summary of txpool_inspect, such as "0x...: 1 wei + 21000 gas × 1 wei".
+type TxPoolInspect struct {
+	Pending map[common.Address]map[uint64]string `json:"pending"`
+	Queued  map[common.Address]map[uint64]string `json:"queued"`
+}
+
+type TxPoolStatus struct {
+	Pending uint64
+	Queued  uint64
+}
+
+type TxPoolIssue struct {
+	Reason string
+	Nonce  uint64
+	// Hash is empty for a nonce gap, there is no transaction.
+	Hash   common.Hash
+	Detail string
+}
+
+// TxPoolDiagnosis explains why the transactions of an account are not being mined.
+type TxPoolDiagnosis struct {
+	Account        common.Address
+	ConfirmedNonce uint64
+	Pending        []*types.Transaction
+	Queued         []*types.Transaction
+	Issues         []*TxPoolIssue
+}
+
+// Stuck reports whether anything keeps the transactions of the account from being mined.
+func (d *TxPoolDiagnosis) Stuck() bool {
+	return len(d.Issues) > 0
+}
+
+func (ec *EvmClient) TxPoolContent(ctx context.Context) (*TxPoolContent, error) {
+	abiMethod := consts.EvmTxPoolMethodContent
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	result := &TxPoolContent{}
+	if err := ec.rpcClient.CallContext(ctx, result, "txpool_content"); err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return result, nil
+}
+func (ec *EvmClient) TxPoolContentFrom(ctx context.Context, account common.Address) (*TxPoolAccountContent, error) {
+	abiMethod := consts.EvmTxPoolMethodContentFrom
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	result, err := ec._txPoolContentFrom(ctx, account)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return result, nil
+}
+func (ec *EvmClient) TxPoolInspect(ctx context.Context) (*TxPoolInspect, error) {
+	abiMethod := consts.EvmTxPoolMethodInspect
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	result := &TxPoolInspect{}
+	if err := ec.rpcClient.CallContext(ctx, result, "txpool_inspect"); err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return result, nil
+}
+func (ec *EvmClient) TxPoolStatus(ctx context.Context) (*TxPoolStatus, error) {
+	abiMethod := consts.EvmTxPoolMethodStatus
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	var result struct {
+		Pending hexutil.Uint64 `json:"pending"`
+		Queued  hexutil.Uint64 `json:"queued"`
+	}
+	if err := ec.rpcClient.CallContext(ctx, &result, "txpool_status"); err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return &TxPoolStatus{Pending: uint64(result.Pending), Queued: uint64(result.Queued)}, nil
+}
+
+// DiagnoseTxPool looks up the pooled transactions of account and reports every missing nonce between
+// the confirmed nonce and the highest pooled one, and every transaction that cannot be mined at current
+// prices: legacy and access list transactions below the suggested gas price, dynamic fee transactions
+// whose fee cap is below the base fee of the pending block.
+func (ec *EvmClient) DiagnoseTxPool(ctx context.Context, account common.Address) (*TxPoolDiagnosis, error) {
+	abiMethod := consts.EvmTxPoolMethodDiagnose
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	result, err := ec._diagnoseTxPool(ctx, account)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return result, nil
+}
+
+func (ec *EvmClient) _txPoolContentFrom(ctx context.Context, account common.Address) (*TxPoolAccountContent, error) {
+	result := &TxPoolAccountContent{}
+	if err := ec.rpcClient.CallContext(ctx, result, "txpool_contentFrom", account); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (ec *EvmClient) _diagnoseTxPool(ctx context.Context, account common.Address) (*TxPoolDiagnosis, error) {
+	confirmedNonce, err := ec.ethClient.NonceAt(ctx, account, nil)
+	if err != nil {
+		return nil, err
+	}
+	content, err := ec._txPoolContentFrom(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	result := &TxPoolDiagnosis{
+		Account:        account,
+		ConfirmedNonce: confirmedNonce,
+		Pending:        _sortedByNonce(content.Pending),
+		Queued:         _sortedByNonce(content.Queued),
+	}
+	pooledTxs := append(append([]*types.Transaction{}, result.Pending...), result.Queued...)
+	pooled := make(map[uint64]bool, len(pooledTxs))
+	highest := confirmedNonce
+	for _, tx := range pooledTxs {
+		pooled[tx.Nonce()] = true
+		if tx.Nonce() > highest {
+			highest = tx.Nonce()
+		}
+	}
+	for nonce := confirmedNonce; nonce < highest; nonce++ {
+		if !pooled[nonce] {
+			result.Issues = append(result.Issues, &TxPoolIssue{
+				Reason: TxPoolIssueNonceGap,
+				Nonce:  nonce,
+				Detail: fmt.Sprintf("nonce %d is missing, later transactions stay queued", nonce),
+			})
+		}
+	}
+	var gasPrice, baseFee *big.Int
+	for _, tx := range pooledTxs {
+		var detail string
+		switch tx.Type() {
+		case types.LegacyTxType, types.AccessListTxType:
+			if gasPrice == nil {
+				if gasPrice, err = ec.ethClient.SuggestGasPrice(ctx); err != nil {
+					return nil, err
+				}
+			}
+			if tx.GasPrice().Cmp(gasPrice) < 0 {
+				detail = fmt.Sprintf("gas price %s below current gas price %s", tx.GasPrice(), gasPrice)
+			}
+		default:
+			if baseFee == nil {
+				if baseFee, err = ec._pendingBaseFee(ctx); err != nil {
+					return nil, err
+				}
+			}
+			if tx.GasFeeCap().Cmp(baseFee) < 0 {
+				detail = fmt.Sprintf("fee cap %s below pending base fee %s", tx.GasFeeCap(), baseFee)
+			}
+		}
+		if detail != "" {
+			result.Issues = append(result.Issues, &TxPoolIssue{
+				Reason: TxPoolIssueUnderpriced,
+				Nonce:  tx.Nonce(),
+				Hash:   tx.Hash(),
+				Detail: detail,
+			})
+		}
+	}
+	sort.SliceStable(result.Issues, func(i, j int) bool {
+		return result.Issues[i].Nonce < result.Issues[j].Nonce
+	})
+	return result, nil
+}
+
+// _pendingBaseFee returns the base fee of the next block, which eth_feeHistory derives from the latest header.
+func (ec *EvmClient) _pendingBaseFee(ctx context.Context) (*big.Int, error) {
+	history, err := ec.ethClient.FeeHistory(ctx, 1, nil, []float64{})
+	if err != nil {
+		return nil, err
+	}
+	if len(history.BaseFee) == 0 {
+		return nil, errors.New("fee history without base fee")
+	}
+	return history.BaseFee[len(history.BaseFee)-1], nil
+}
+
+func _sortedByNonce(txs map[uint64]*types.Transaction) []*types.Transaction {
+	result := make([]*types.Transaction, 0, len(txs))
+	for _, tx := range txs {
+		result = append(result, tx)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Nonce() < result[j].Nonce()
+	})
+	return result
+}
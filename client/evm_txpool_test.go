@@ -0,0 +1,134 @@
+package client
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+// testTxPoolService holds an account with confirmed nonce 5, an underpriced nonce 5 and a queued nonce 7.
+type testTxPoolService struct {
+	account common.Address
+	pending map[string]*types.Transaction
+	queued  map[string]*types.Transaction
+}
+
+func (s *testTxPoolService) Content() map[string]map[common.Address]map[string]*types.Transaction {
+	return map[string]map[common.Address]map[string]*types.Transaction{
+		"pending": {s.account: s.pending},
+		"queued":  {s.account: s.queued},
+	}
+}
+
+func (s *testTxPoolService) ContentFrom(account common.Address) map[string]map[string]*types.Transaction {
+	if account != s.account {
+		return map[string]map[string]*types.Transaction{"pending": {}, "queued": {}}
+	}
+	return map[string]map[string]*types.Transaction{"pending": s.pending, "queued": s.queued}
+}
+
+func (s *testTxPoolService) Inspect() map[string]map[common.Address]map[string]string {
+	return map[string]map[common.Address]map[string]string{
+		"pending": {s.account: {"5": "0x2000000000000000000000000000000000000002: 1 wei + 21000 gas × 1000000000 wei"}},
+		"queued":  {s.account: {"7": "0x2000000000000000000000000000000000000002: 1 wei + 21000 gas × 3000000000 wei"}},
+	}
+}
+
+func (s *testTxPoolService) Status() map[string]hexutil.Uint {
+	return map[string]hexutil.Uint{"pending": 1, "queued": 1}
+}
+
+// testTxPoolEthService suggests a 2 gwei gas price while the pending block has a 1.5 gwei base fee.
+type testTxPoolEthService struct {
+	testTxService
+}
+
+func (s *testTxPoolEthService) FeeHistory(blockCount hexutil.Uint64, lastBlock string, percentiles []float64) map[string]interface{} {
+	_, _, _ = blockCount, lastBlock, percentiles
+	return map[string]interface{}{
+		"oldestBlock":   "0x10",
+		"baseFeePerGas": []string{"0x3b9aca00", "0x59682f00"},
+		"gasUsedRatio":  []float64{1},
+	}
+}
+
+func TestEvmClient_Unite_TxPool(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	assert.Nil(t, err)
+	account := crypto.PubkeyToAddress(key.PublicKey)
+	to := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	newTx := func(nonce uint64, gasPrice int64) *types.Transaction {
+		tx, err := types.SignNewTx(key, types.LatestSignerForChainID(big.NewInt(1)), &types.LegacyTx{
+			Nonce: nonce, GasPrice: big.NewInt(gasPrice), Gas: 21000, To: &to, Value: big.NewInt(1),
+		})
+		assert.Nil(t, err)
+		return tx
+	}
+	service := &testTxPoolService{
+		account: account,
+		pending: map[string]*types.Transaction{"5": newTx(5, 1e9)},
+		queued:  map[string]*types.Transaction{"7": newTx(7, 3e9)},
+	}
+	ec := _testEvmClient(t, map[string]interface{}{"txpool": service, "eth": &testTxPoolEthService{testTxService{nonce: 5, gasPrice: 2e9}}}, nil)
+
+	t.Run("TxPoolContent", func(t *testing.T) {
+		content, err := ec.TxPoolContent(testCtx)
+		assert.Nil(t, err)
+		assert.Equal(t, service.pending["5"].Hash(), content.Pending[account][5].Hash())
+		assert.Equal(t, service.queued["7"].Hash(), content.Queued[account][7].Hash())
+		from, err := ec.TxPoolContentFrom(testCtx, account)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(7), from.Queued[7].Nonce())
+	})
+	t.Run("TxPoolInspect", func(t *testing.T) {
+		inspect, err := ec.TxPoolInspect(testCtx)
+		assert.Nil(t, err)
+		assert.Contains(t, inspect.Queued[account][7], "3000000000 wei")
+		status, err := ec.TxPoolStatus(testCtx)
+		assert.Nil(t, err)
+		assert.Equal(t, &TxPoolStatus{Pending: 1, Queued: 1}, status)
+	})
+	t.Run("DiagnoseTxPool", func(t *testing.T) {
+		diagnosis, err := ec.DiagnoseTxPool(testCtx, account)
+		assert.Nil(t, err)
+		assert.True(t, diagnosis.Stuck())
+		assert.Equal(t, uint64(5), diagnosis.ConfirmedNonce)
+		assert.Equal(t, 2, len(diagnosis.Issues))
+		assert.Equal(t, TxPoolIssueUnderpriced, diagnosis.Issues[0].Reason)
+		assert.Equal(t, service.pending["5"].Hash(), diagnosis.Issues[0].Hash)
+		assert.Equal(t, TxPoolIssueNonceGap, diagnosis.Issues[1].Reason)
+		assert.Equal(t, uint64(6), diagnosis.Issues[1].Nonce)
+
+		diagnosis, err = ec.DiagnoseTxPool(testCtx, to)
+		assert.Nil(t, err)
+		assert.False(t, diagnosis.Stuck())
+	})
+	t.Run("DiagnoseTxPoolDynamicFee", func(t *testing.T) {
+		legacy := service.pending["5"]
+		defer func() { service.pending["5"] = legacy }()
+		newDynamicTx := func(feeCap int64) *types.Transaction {
+			tx, err := types.SignNewTx(key, types.LatestSignerForChainID(big.NewInt(1)), &types.DynamicFeeTx{
+				ChainID: big.NewInt(1), Nonce: 5, GasTipCap: big.NewInt(1e9), GasFeeCap: big.NewInt(feeCap), Gas: 21000, To: &to, Value: big.NewInt(1),
+			})
+			assert.Nil(t, err)
+			return tx
+		}
+		service.pending["5"] = newDynamicTx(18e8)
+		diagnosis, err := ec.DiagnoseTxPool(testCtx, account)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(diagnosis.Issues))
+		assert.Equal(t, TxPoolIssueNonceGap, diagnosis.Issues[0].Reason)
+
+		service.pending["5"] = newDynamicTx(1e9)
+		diagnosis, err = ec.DiagnoseTxPool(testCtx, account)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(diagnosis.Issues))
+		assert.Equal(t, TxPoolIssueUnderpriced, diagnosis.Issues[0].Reason)
+		assert.Contains(t, diagnosis.Issues[0].Detail, "pending base fee 1500000000")
+	})
+}
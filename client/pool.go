@@ -1,7 +1,17 @@
 package client
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
 	"github.com/6boris/web3-go/model/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/go-kratos/aegis/circuitbreaker"
 	"github.com/go-kratos/aegis/circuitbreaker/sre"
 )
@@ -12,6 +22,22 @@ type Pool struct {
 	breakerGroup   *circuitbreaker.CircuitBreaker
 	_evmClients    map[int64]map[string]*EvmClient
 	_solanaClients map[string]*SolanaClient
+	_tokenRegistry *TokenRegistry
+	_bundlers      map[int64][]*BundlerClient
+	_breakers      map[string]circuitbreaker.CircuitBreaker
+	_latencies     sync.Map
+}
+
+var ErrBroadcastFailed = errors.New("broadcast failed on every provider")
+
+// BroadcastOutcome is the result of sending a transaction to one provider. AlreadyKnown outcomes count as
+// success, the provider had the transaction already.
+type BroadcastOutcome struct {
+	ClientID     string
+	Provider     string
+	Private      bool
+	AlreadyKnown bool
+	Err          error
 }
 
 // func init() {
@@ -38,6 +64,9 @@ func NewPool(conf *client.ConfPool) *Pool {
 	p := &Pool{
 		conf:           conf,
 		_solanaClients: map[string]*SolanaClient{},
+		_tokenRegistry: NewTokenRegistry(),
+		_bundlers:      map[int64][]*BundlerClient{},
+		_breakers:      map[string]circuitbreaker.CircuitBreaker{},
 	}
 	b := sre.NewBreaker()
 	p.breakerGroup = &b
@@ -47,22 +76,41 @@ func NewPool(conf *client.ConfPool) *Pool {
 		if _, ok := p._evmClients[chain.ChainID]; !ok {
 			p._evmClients[chain.ChainID] = make(map[string]*EvmClient, 0)
 		}
+		var chainClient *EvmClient
 		for _, c := range chain.Clients {
 			if c.TransportSchema == "https" {
 				tmpC, err := NewEvmClient(c)
+				if err != nil {
+					panic(err)
+				}
 				tmpC._appID = conf.AppID
 				tmpC._zone = conf.Zone
 				tmpC._cluster = conf.Cluster
 				tmpC._ethChainID = chain.ChainID
 				tmpC._ethChainName = chain.ChainName
 				tmpC._ethChainEnv = chain.ChainEnv
-				if err != nil {
-					panic(err)
-				} else {
-					p._evmClients[chain.ChainID][tmpC._clientID] = tmpC
+				tmpC._nativeSymbol = chain.NativeSymbol
+				if tmpC._chainFamily == "" {
+					tmpC._chainFamily = chain.ChainFamily
+				}
+				tmpC._tokenCache = p._tokenRegistry
+				p._evmClients[chain.ChainID][tmpC._clientID] = tmpC
+				p._breakers[tmpC._clientID] = sre.NewBreaker()
+				if chainClient == nil {
+					chainClient = tmpC
 				}
 			}
 		}
+		for _, c := range chain.Bundlers {
+			if chainClient == nil {
+				panic(fmt.Errorf("bundler %s of chain %d has no https client to sign with", c.ClientID, chain.ChainID))
+			}
+			tmpB, err := NewBundlerClient(c, chainClient)
+			if err != nil {
+				panic(err)
+			}
+			p._bundlers[chain.ChainID] = append(p._bundlers[chain.ChainID], tmpB)
+		}
 	}
 	for _, c := range p.conf.SolanaChains {
 		loopClient, loopErr := NewSolanaClient(c)
@@ -70,6 +118,11 @@ func NewPool(conf *client.ConfPool) *Pool {
 			p._solanaClients[loopClient.ClientID] = loopClient
 		}
 	}
+	for _, path := range p.conf.TokenLists {
+		if _, err := p._tokenRegistry.LoadTokenListFile(path); err != nil {
+			panic(err)
+		}
+	}
 	return p
 }
 
@@ -83,6 +136,16 @@ func (p *Pool) GetEvmClient(chainID int64) *EvmClient {
 	}
 	return nil
 }
+
+// GetBundlerClient returns a bundler of chainID for entryPoint, the first configured one when entryPoint is zero.
+func (p *Pool) GetBundlerClient(chainID int64, entryPoint common.Address) *BundlerClient {
+	for _, bc := range p._bundlers[chainID] {
+		if entryPoint == (common.Address{}) || bc._entryPoint == entryPoint {
+			return bc
+		}
+	}
+	return nil
+}
 func (p *Pool) GetSolanaClient(chainEnv string) *SolanaClient {
 	if len(p._solanaClients) == 0 {
 		return nil
@@ -94,3 +157,114 @@ func (p *Pool) GetSolanaClient(chainEnv string) *SolanaClient {
 	}
 	return nil
 }
+
+// BroadcastTransaction sends a signed tx to BroadcastFanout healthy providers of chainID in parallel, the
+// fastest ones first by their past broadcasts, providers whose circuit breaker is open are skipped. When
+// the sender has a private relay on one of the clients, tx only goes to that relay so it never reaches a
+// public mempool. The error is nil as soon as one provider accepted tx or already knew it.
+func (p *Pool) BroadcastTransaction(ctx context.Context, chainID int64, tx *types.Transaction) ([]*BroadcastOutcome, error) {
+	candidates := make([]*EvmClient, 0, len(p._evmClients[chainID]))
+	for _, ec := range p._evmClients[chainID] {
+		relay, err := ec._senderRelay(tx)
+		if err != nil {
+			return nil, err
+		}
+		if relay != nil {
+			_, err = ec.SendPrivateTransaction(ctx, tx)
+			outcome := &BroadcastOutcome{ClientID: ec._clientID, Provider: relay.Provider(), Private: true, Err: err}
+			return []*BroadcastOutcome{outcome}, _broadcastError([]*BroadcastOutcome{outcome})
+		}
+		candidates = append(candidates, ec)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return p._latency(candidates[i]._clientID) < p._latency(candidates[j]._clientID)
+	})
+	fanout := p._broadcastFanout(chainID)
+	clients := make([]*EvmClient, 0, len(candidates))
+	for _, ec := range candidates {
+		if fanout > 0 && len(clients) == fanout {
+			break
+		}
+		if p._breakers[ec._clientID].Allow() == nil {
+			clients = append(clients, ec)
+		}
+	}
+	if len(clients) == 0 {
+		return nil, fmt.Errorf("%w: no healthy client for chain %d", ErrBroadcastFailed, chainID)
+	}
+	outcomes := make([]*BroadcastOutcome, len(clients))
+	wg := sync.WaitGroup{}
+	for i, ec := range clients {
+		wg.Add(1)
+		go func(i int, ec *EvmClient) {
+			defer wg.Done()
+			outcome := &BroadcastOutcome{ClientID: ec._clientID, Provider: ec._provider}
+			startAt := time.Now()
+			if err := ec.SendTransaction(ctx, tx); err != nil {
+				outcome.AlreadyKnown = _isAlreadyKnown(err)
+				if !outcome.AlreadyKnown {
+					outcome.Err = err
+				}
+			}
+			p._markLatency(ec._clientID, time.Since(startAt))
+			p._markBreaker(ec._clientID, outcome.Err)
+			outcomes[i] = outcome
+		}(i, ec)
+	}
+	wg.Wait()
+	return outcomes, _broadcastError(outcomes)
+}
+
+// _latency is the moving average of the broadcast latency of a client, zero until it was used once so
+// that new clients get tried.
+func (p *Pool) _latency(clientID string) time.Duration {
+	if latency, ok := p._latencies.Load(clientID); ok {
+		return latency.(time.Duration)
+	}
+	return 0
+}
+func (p *Pool) _markLatency(clientID string, latency time.Duration) {
+	if previous := p._latency(clientID); previous > 0 {
+		latency = (previous*3 + latency) / 4
+	}
+	p._latencies.Store(clientID, latency)
+}
+
+func (p *Pool) _broadcastFanout(chainID int64) int {
+	for _, chain := range p.conf.EvmChains {
+		if chain.ChainID == chainID {
+			return chain.BroadcastFanout
+		}
+	}
+	return 0
+}
+
+// _markBreaker only counts transport failures against the provider. A JSON-RPC error means the node is
+// up and rejected tx, such as for a nonce too low.
+func (p *Pool) _markBreaker(clientID string, err error) {
+	var rpcErr interface{ ErrorCode() int }
+	if err != nil && !errors.As(err, &rpcErr) {
+		p._breakers[clientID].MarkFailed()
+		return
+	}
+	p._breakers[clientID].MarkSuccess()
+}
+
+func _broadcastError(outcomes []*BroadcastOutcome) error {
+	errs := make([]error, 0, len(outcomes))
+	for _, outcome := range outcomes {
+		if outcome.Err == nil {
+			return nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", outcome.Provider, outcome.Err))
+	}
+	return fmt.Errorf("%w: %w", ErrBroadcastFailed, errors.Join(errs...))
+}
+
+// _alreadyKnownPattern matches the duplicate transaction errors of geth and erigon ("already known"), older
+// geth and besu ("known transaction"), nethermind ("AlreadyKnown") and openethereum.
+var _alreadyKnownPattern = regexp.MustCompile(`(?i)\b(already known|known transaction|alreadyknown|transaction_already_known|transaction with the same hash was already imported)\b`)
+
+func _isAlreadyKnown(err error) bool {
+	return _alreadyKnownPattern.MatchString(err.Error())
+}
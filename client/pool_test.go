@@ -3,13 +3,22 @@ package client
 import (
 	"context"
 	"crypto/ecdsa"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"log"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/6boris/web3-go/consts"
+	clientModel "github.com/6boris/web3-go/model/client"
 	"github.com/6boris/web3-go/model/solana"
+	"github.com/6boris/web3-go/pkg/pk"
 	"github.com/davecgh/go-spew/spew"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
@@ -81,3 +90,121 @@ func Test_Unite_Pool(t *testing.T) {
 		spew.Dump(resp)
 	})
 }
+
+type testBroadcastService struct {
+	testTxService
+	err error
+}
+
+func (s *testBroadcastService) SendRawTransaction(input hexutil.Bytes) (common.Hash, error) {
+	hash, err := s.testTxService.SendRawTransaction(input)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return hash, s.err
+}
+
+func TestPool_Unite_BroadcastTransaction(t *testing.T) {
+	key, err := crypto.HexToECDSA(hex.EncodeToString(crypto.Keccak256([]byte("broadcast"))))
+	assert.Nil(t, err)
+	to := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(big.NewInt(1)), &types.LegacyTx{
+		Nonce: 0, GasPrice: big.NewInt(1e9), Gas: 21000, To: &to, Value: big.NewInt(1),
+	})
+	assert.Nil(t, err)
+	newNode := func(name string, service interface{}) *clientModel.ConfEvmChainClient {
+		return &clientModel.ConfEvmChainClient{Provider: name, TransportSchema: "https", TransportURL: _testRPCServer(t, map[string]interface{}{"eth": service})}
+	}
+	accepting := &testBroadcastService{}
+	known := &testBroadcastService{err: errors.New("already known")}
+	rejecting := &testBroadcastService{err: errors.New("nonce too low")}
+	newPool := func(fanout int, clients ...*clientModel.ConfEvmChainClient) *Pool {
+		return NewPool(&clientModel.ConfPool{EvmChains: map[int64]*clientModel.ConfEvmChainInfo{
+			1: {ChainID: 1, BroadcastFanout: fanout, Clients: clients},
+		}})
+	}
+
+	t.Run("AllProviders", func(t *testing.T) {
+		pool := newPool(0, newNode("accepting", accepting), newNode("known", known), newNode("rejecting", rejecting))
+		outcomes, err := pool.BroadcastTransaction(testCtx, 1, tx)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(outcomes))
+		for _, outcome := range outcomes {
+			switch outcome.Provider {
+			case "accepting":
+				assert.Nil(t, outcome.Err)
+			case "known":
+				assert.Nil(t, outcome.Err)
+				assert.True(t, outcome.AlreadyKnown)
+			case "rejecting":
+				assert.EqualError(t, outcome.Err, "nonce too low")
+			}
+		}
+	})
+	t.Run("AlreadyKnownOnly", func(t *testing.T) {
+		outcomes, err := newPool(0, newNode("known", known)).BroadcastTransaction(testCtx, 1, tx)
+		assert.Nil(t, err)
+		assert.True(t, outcomes[0].AlreadyKnown)
+	})
+	t.Run("Fanout", func(t *testing.T) {
+		pool := newPool(2, newNode("a", &testBroadcastService{}), newNode("b", &testBroadcastService{}), newNode("c", &testBroadcastService{}))
+		outcomes, err := pool.BroadcastTransaction(testCtx, 1, tx)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(outcomes))
+	})
+	t.Run("FanoutLatency", func(t *testing.T) {
+		slow, fast := &testBroadcastService{}, &testBroadcastService{}
+		pool := newPool(1, newNode("slow", slow), newNode("fast", fast))
+		for _, ec := range pool._evmClients[1] {
+			latency := time.Millisecond
+			if ec._provider == "slow" {
+				latency = time.Second
+			}
+			pool._markLatency(ec._clientID, latency)
+		}
+		for i := 0; i < 3; i++ {
+			outcomes, err := pool.BroadcastTransaction(testCtx, 1, tx)
+			assert.Nil(t, err)
+			assert.Equal(t, "fast", outcomes[0].Provider)
+		}
+		assert.Equal(t, 0, len(slow.sent))
+		assert.Equal(t, 3, len(fast.sent))
+	})
+	t.Run("AlreadyKnown", func(t *testing.T) {
+		for msg, known := range map[string]bool{
+			"already known":             true,
+			"known transaction: 0x5d4b": true,
+			"AlreadyKnown":              true,
+			"Transaction with the same hash was already imported.": true,
+			"rlp: unknown transaction type":                        false,
+			"contract already exists":                              false,
+			"nonce too low":                                        false,
+		} {
+			assert.Equal(t, known, _isAlreadyKnown(errors.New(msg)), msg)
+		}
+	})
+	t.Run("Failed", func(t *testing.T) {
+		outcomes, err := newPool(0, newNode("rejecting", rejecting)).BroadcastTransaction(testCtx, 1, tx)
+		assert.ErrorIs(t, err, ErrBroadcastFailed)
+		assert.Equal(t, 1, len(outcomes))
+		_, err = newPool(0).BroadcastTransaction(testCtx, 1, tx)
+		assert.ErrorIs(t, err, ErrBroadcastFailed)
+	})
+	t.Run("PrivateRelay", func(t *testing.T) {
+		relayService := &testRelayService{}
+		relay := newNode("FlashBots", relayService)
+		signer, err := pk.TransformPkToEvmSigner(hex.EncodeToString(crypto.FromECDSA(key)))
+		assert.Nil(t, err)
+		signer.PrivateRelay = &clientModel.ConfEvmPrivateRelay{Provider: "FlashBots", TransportURL: relay.TransportURL, AuthPrivateKey: key}
+		node := newNode("node", &testPublicNodeService{})
+		node.Signers = []*clientModel.ConfEvmChainSigner{signer}
+		public := &testBroadcastService{}
+		outcomes, err := newPool(0, node, newNode("public", public)).BroadcastTransaction(testCtx, 1, tx)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(outcomes))
+		assert.True(t, outcomes[0].Private)
+		assert.Equal(t, "FlashBots", outcomes[0].Provider)
+		assert.Equal(t, 1, len(relayService.private))
+		assert.Equal(t, 0, len(public.sent))
+	})
+}
@@ -0,0 +1,411 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/6boris/web3-go/consts"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/6boris/web3-go/pkg/pk"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	FlashbotsSignatureHeader     = "X-Flashbots-Signature"
+	PrivateRelayDefaultMaxBlocks = 25
+)
+
+var (
+	ErrPrivateRelayNotConfig = errors.New("private relay not config for signer")
+	ErrPrivateRelayAuthKey   = errors.New("private relay auth key not config")
+	ErrBundleEmpty           = errors.New("bundle has no transactions")
+)
+
+// PrivateRelay submits transactions to a MEV protection relay. Every request carries the
+// X-Flashbots-Signature header, relays which do not authenticate ignore it.
+type PrivateRelay struct {
+	rpcClient     *rpc.Client
+	_provider     string
+	_transportURL string
+	_authAddress  common.Address
+	_maxBlocks    uint64
+}
+
+// Bundle is a Flashbots bundle targeting BlockNumber. Transactions listed in RevertingTxHashes may revert
+// without invalidating the bundle.
+type Bundle struct {
+	Txs               []*types.Transaction
+	BlockNumber       *big.Int
+	MinTimestamp      uint64
+	MaxTimestamp      uint64
+	RevertingTxHashes []common.Hash
+}
+
+type BundleCallResult struct {
+	BundleHash        common.Hash
+	BundleGasPrice    *big.Int
+	CoinbaseDiff      *big.Int
+	EthSentToCoinbase *big.Int
+	GasFees           *big.Int
+	StateBlockNumber  uint64
+	TotalGasUsed      uint64
+	Results           []*BundleTxResult
+}
+
+type BundleTxResult struct {
+	TxHash       common.Hash
+	FromAddress  common.Address
+	ToAddress    common.Address
+	GasUsed      uint64
+	GasPrice     *big.Int
+	CoinbaseDiff *big.Int
+	Value        []byte
+	Error        string
+	Revert       string
+}
+
+type _flashbotsTransport struct {
+	authKey *ecdsa.PrivateKey
+	base    http.RoundTripper
+}
+
+// RoundTrip signs the keccak256 hex of the body with EIP-191, the authentication Flashbots expects.
+func (t *_flashbotsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body == nil {
+		return t.base.RoundTrip(req)
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	_ = req.Body.Close()
+	signature, err := crypto.Sign(pk.PersonalMessageHash([]byte(crypto.Keccak256Hash(body).Hex())), t.authKey)
+	if err != nil {
+		return nil, err
+	}
+	signed := req.Clone(req.Context())
+	signed.Body = io.NopCloser(bytes.NewReader(body))
+	signed.ContentLength = int64(len(body))
+	signed.Header.Set(FlashbotsSignatureHeader, crypto.PubkeyToAddress(t.authKey.PublicKey).Hex()+":"+hexutil.Encode(signature))
+	return t.base.RoundTrip(signed)
+}
+
+// NewPrivateRelay dials the relay of conf. Flashbots needs a stable AuthPrivateKey to keep its reputation
+// across restarts, for other relays a missing key is replaced by a random one.
+func NewPrivateRelay(conf *clientModel.ConfEvmPrivateRelay) (*PrivateRelay, error) {
+	authKey := conf.AuthPrivateKey
+	if authKey == nil {
+		if strings.EqualFold(conf.Provider, consts.PrivateRelayProviderFlashbots) {
+			return nil, fmt.Errorf("%w: %s", ErrPrivateRelayAuthKey, conf.Provider)
+		}
+		var err error
+		if authKey, err = crypto.GenerateKey(); err != nil {
+			return nil, err
+		}
+	}
+	pr := &PrivateRelay{
+		_provider:     conf.Provider,
+		_transportURL: conf.TransportURL,
+		_authAddress:  crypto.PubkeyToAddress(authKey.PublicKey),
+		_maxBlocks:    conf.MaxBlocks,
+	}
+	if pr._maxBlocks == 0 {
+		pr._maxBlocks = PrivateRelayDefaultMaxBlocks
+	}
+	httpClient := &http.Client{Transport: &_flashbotsTransport{authKey: authKey, base: http.DefaultTransport}}
+	var err error
+	pr.rpcClient, err = rpc.DialOptions(context.Background(), conf.TransportURL, rpc.WithHTTPClient(httpClient))
+	if err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+func (pr *PrivateRelay) Close() {
+	pr.rpcClient.Close()
+}
+func (pr *PrivateRelay) Provider() string {
+	return pr._provider
+}
+
+// AuthAddress is the address behind X-Flashbots-Signature, the identity Flashbots builds reputation for.
+func (pr *PrivateRelay) AuthAddress() common.Address {
+	return pr._authAddress
+}
+
+// SendPrivateTransaction submits tx with eth_sendPrivateTransaction, the relay stops trying after maxBlockNumber.
+func (pr *PrivateRelay) SendPrivateTransaction(ctx context.Context, tx *types.Transaction, maxBlockNumber *big.Int) (common.Hash, error) {
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	arg := map[string]interface{}{"tx": hexutil.Bytes(raw)}
+	if maxBlockNumber != nil {
+		arg["maxBlockNumber"] = (*hexutil.Big)(maxBlockNumber)
+	}
+	var result common.Hash
+	if err = pr.rpcClient.CallContext(ctx, &result, "eth_sendPrivateTransaction", arg); err != nil {
+		return common.Hash{}, err
+	}
+	return result, nil
+}
+
+// SendBundle submits bundle with eth_sendBundle and returns the bundle hash.
+func (pr *PrivateRelay) SendBundle(ctx context.Context, bundle *Bundle) (common.Hash, error) {
+	arg, err := _toBundleArg(bundle.Txs, bundle.BlockNumber)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if bundle.MinTimestamp > 0 {
+		arg["minTimestamp"] = bundle.MinTimestamp
+	}
+	if bundle.MaxTimestamp > 0 {
+		arg["maxTimestamp"] = bundle.MaxTimestamp
+	}
+	if len(bundle.RevertingTxHashes) > 0 {
+		arg["revertingTxHashes"] = bundle.RevertingTxHashes
+	}
+	var result struct {
+		BundleHash common.Hash `json:"bundleHash"`
+	}
+	if err = pr.rpcClient.CallContext(ctx, &result, "eth_sendBundle", arg); err != nil {
+		return common.Hash{}, err
+	}
+	return result.BundleHash, nil
+}
+
+// CallBundle simulates txs as a bundle of blockNumber on top of the latest state with eth_callBundle.
+func (pr *PrivateRelay) CallBundle(ctx context.Context, txs []*types.Transaction, blockNumber *big.Int) (*BundleCallResult, error) {
+	arg, err := _toBundleArg(txs, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	arg["stateBlockNumber"] = "latest"
+	var result struct {
+		BundleHash        common.Hash `json:"bundleHash"`
+		BundleGasPrice    string      `json:"bundleGasPrice"`
+		CoinbaseDiff      string      `json:"coinbaseDiff"`
+		EthSentToCoinbase string      `json:"ethSentToCoinbase"`
+		GasFees           string      `json:"gasFees"`
+		StateBlockNumber  uint64      `json:"stateBlockNumber"`
+		TotalGasUsed      uint64      `json:"totalGasUsed"`
+		Results           []struct {
+			TxHash       common.Hash    `json:"txHash"`
+			FromAddress  common.Address `json:"fromAddress"`
+			ToAddress    common.Address `json:"toAddress"`
+			GasUsed      uint64         `json:"gasUsed"`
+			GasPrice     string         `json:"gasPrice"`
+			CoinbaseDiff string         `json:"coinbaseDiff"`
+			Value        hexutil.Bytes  `json:"value"`
+			Error        string         `json:"error"`
+			Revert       string         `json:"revert"`
+		} `json:"results"`
+	}
+	if err = pr.rpcClient.CallContext(ctx, &result, "eth_callBundle", arg); err != nil {
+		return nil, err
+	}
+	callResult := &BundleCallResult{
+		BundleHash:        result.BundleHash,
+		BundleGasPrice:    _parseDecimalBig(result.BundleGasPrice),
+		CoinbaseDiff:      _parseDecimalBig(result.CoinbaseDiff),
+		EthSentToCoinbase: _parseDecimalBig(result.EthSentToCoinbase),
+		GasFees:           _parseDecimalBig(result.GasFees),
+		StateBlockNumber:  result.StateBlockNumber,
+		TotalGasUsed:      result.TotalGasUsed,
+		Results:           make([]*BundleTxResult, 0, len(result.Results)),
+	}
+	for _, v := range result.Results {
+		callResult.Results = append(callResult.Results, &BundleTxResult{
+			TxHash:       v.TxHash,
+			FromAddress:  v.FromAddress,
+			ToAddress:    v.ToAddress,
+			GasUsed:      v.GasUsed,
+			GasPrice:     _parseDecimalBig(v.GasPrice),
+			CoinbaseDiff: _parseDecimalBig(v.CoinbaseDiff),
+			Value:        v.Value,
+			Error:        v.Error,
+			Revert:       v.Revert,
+		})
+	}
+	return callResult, nil
+}
+
+// SendPrivateTransaction sends a signed transaction through the private relay configured for its sender.
+func (ec *EvmClient) SendPrivateTransaction(ctx context.Context, tx *types.Transaction) (common.Hash, error) {
+	abiMethod := consts.EvmMethodSendPrivateTransaction
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	relay, err := ec._senderRelay(tx)
+	if err == nil && relay == nil {
+		err = ErrPrivateRelayNotConfig
+	}
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return common.Hash{}, err
+	}
+	hash, err := ec._sendPrivateTransaction(ctx, relay, tx)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return common.Hash{}, err
+	}
+	return hash, nil
+}
+
+// SendBundle sends bundle through the private relay of the sender of its first transaction. A nil
+// BlockNumber targets the next block.
+func (ec *EvmClient) SendBundle(ctx context.Context, bundle *Bundle) (common.Hash, error) {
+	abiMethod := consts.EvmMethodSendBundle
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	relay, blockNumber, err := ec._bundleTarget(ctx, bundle.Txs, bundle.BlockNumber)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return common.Hash{}, err
+	}
+	target := *bundle
+	target.BlockNumber = blockNumber
+	hash, err := relay.SendBundle(ctx, &target)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return common.Hash{}, err
+	}
+	return hash, nil
+}
+
+// CallBundle simulates txs through the private relay of the sender of the first transaction. A nil
+// blockNumber simulates the next block.
+func (ec *EvmClient) CallBundle(ctx context.Context, txs []*types.Transaction, blockNumber *big.Int) (*BundleCallResult, error) {
+	abiMethod := consts.EvmMethodCallBundle
+	meta := &clientModel.Metadata{CallMethod: abiMethod, Status: consts.AbiCallStatusSuccess}
+	ec._beforeHooks(ctx, meta)
+	defer func() {
+		ec._afterHooks(ctx, meta)
+	}()
+	relay, blockNumber, err := ec._bundleTarget(ctx, txs, blockNumber)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	result, err := relay.CallBundle(ctx, txs, blockNumber)
+	if err != nil {
+		meta.Status = consts.AbiCallStatusFail
+		return nil, err
+	}
+	return result, nil
+}
+
+// _senderRelay returns the private relay of the sender of tx, nil when the sender has none.
+func (ec *EvmClient) _senderRelay(tx *types.Transaction) (*PrivateRelay, error) {
+	if len(ec._relays) == 0 {
+		return nil, nil
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return nil, err
+	}
+	return ec._relays[sender], nil
+}
+
+func (ec *EvmClient) _sendPrivateTransaction(ctx context.Context, relay *PrivateRelay, tx *types.Transaction) (common.Hash, error) {
+	current, err := ec.ethClient.BlockNumber(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return relay.SendPrivateTransaction(ctx, tx, new(big.Int).SetUint64(current+relay._maxBlocks))
+}
+
+// _relayBackend is the contract backend of the erc bindings used for writes, it sends their
+// transactions with _sendTransaction so that signers with a private relay never reach the public mempool.
+type _relayBackend struct {
+	*ethclient.Client
+	ec *EvmClient
+}
+
+func (b *_relayBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return b.ec._sendTransaction(ctx, tx)
+}
+
+func (ec *EvmClient) _contractBackend() bind.ContractBackend {
+	return &_relayBackend{Client: ec.ethClient, ec: ec}
+}
+
+// _sendTransaction routes tx to the private relay of its sender when one is configured, otherwise to
+// the public mempool of the provider.
+func (ec *EvmClient) _sendTransaction(ctx context.Context, tx *types.Transaction) error {
+	relay, err := ec._senderRelay(tx)
+	if err != nil {
+		return err
+	}
+	if relay == nil {
+		return ec.ethClient.SendTransaction(ctx, tx)
+	}
+	_, err = ec._sendPrivateTransaction(ctx, relay, tx)
+	return err
+}
+
+func (ec *EvmClient) _bundleTarget(ctx context.Context, txs []*types.Transaction, blockNumber *big.Int) (*PrivateRelay, *big.Int, error) {
+	if len(txs) == 0 {
+		return nil, nil, ErrBundleEmpty
+	}
+	relay, err := ec._senderRelay(txs[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	if relay == nil {
+		return nil, nil, ErrPrivateRelayNotConfig
+	}
+	if blockNumber == nil {
+		current, err := ec.ethClient.BlockNumber(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		blockNumber = new(big.Int).SetUint64(current + 1)
+	}
+	return relay, blockNumber, nil
+}
+
+func _toBundleArg(txs []*types.Transaction, blockNumber *big.Int) (map[string]interface{}, error) {
+	if len(txs) == 0 {
+		return nil, ErrBundleEmpty
+	}
+	if blockNumber == nil {
+		return nil, errors.New("bundle block number is required")
+	}
+	raw := make([]hexutil.Bytes, 0, len(txs))
+	for _, tx := range txs {
+		data, err := tx.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		raw = append(raw, data)
+	}
+	return map[string]interface{}{"txs": raw, "blockNumber": (*hexutil.Big)(blockNumber)}, nil
+}
+
+// _parseDecimalBig reads the decimal strings Flashbots uses for wei amounts, nil when empty or malformed.
+func _parseDecimalBig(v string) *big.Int {
+	result, ok := new(big.Int).SetString(v, 10)
+	if !ok {
+		return nil
+	}
+	return result
+}
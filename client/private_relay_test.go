@@ -0,0 +1,168 @@
+package client
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/6boris/web3-go/pkg/pk"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+type testPublicNodeService struct {
+	testTxService
+}
+
+func (s *testPublicNodeService) BlockNumber() hexutil.Uint64 {
+	return 100
+}
+
+type testRelayService struct {
+	private        []hexutil.Bytes
+	maxBlockNumber *hexutil.Big
+	bundleBlock    *hexutil.Big
+}
+
+func (s *testRelayService) SendPrivateTransaction(arg struct {
+	Tx             hexutil.Bytes `json:"tx"`
+	MaxBlockNumber *hexutil.Big  `json:"maxBlockNumber"`
+}) common.Hash {
+	s.private = append(s.private, arg.Tx)
+	s.maxBlockNumber = arg.MaxBlockNumber
+	return crypto.Keccak256Hash(arg.Tx)
+}
+
+func (s *testRelayService) SendBundle(arg struct {
+	Txs         []hexutil.Bytes `json:"txs"`
+	BlockNumber *hexutil.Big    `json:"blockNumber"`
+}) map[string]common.Hash {
+	s.bundleBlock = arg.BlockNumber
+	return map[string]common.Hash{"bundleHash": crypto.Keccak256Hash(arg.Txs[0])}
+}
+
+func (s *testRelayService) CallBundle(arg struct {
+	Txs              []hexutil.Bytes `json:"txs"`
+	BlockNumber      *hexutil.Big    `json:"blockNumber"`
+	StateBlockNumber string          `json:"stateBlockNumber"`
+}) map[string]interface{} {
+	return map[string]interface{}{
+		"bundleHash":        crypto.Keccak256Hash(arg.Txs[0]),
+		"bundleGasPrice":    "1000000000",
+		"coinbaseDiff":      "21000000000000",
+		"ethSentToCoinbase": "0",
+		"gasFees":           "21000000000000",
+		"stateBlockNumber":  100,
+		"totalGasUsed":      21000,
+		"results": []map[string]interface{}{
+			{"txHash": crypto.Keccak256Hash(arg.Txs[0]), "gasUsed": 21000, "gasPrice": "1000000000", "coinbaseDiff": "21000000000000", "value": "0x"},
+		},
+	}
+}
+
+func TestEvmClient_Unite_PrivateRelay(t *testing.T) {
+	publicService := &testPublicNodeService{}
+
+	authKey, err := crypto.GenerateKey()
+	assert.Nil(t, err)
+	relayService := &testRelayService{}
+	relayServer := rpc.NewServer()
+	assert.Nil(t, relayServer.RegisterName("eth", relayService))
+	var authFailures int
+	relayHTTP := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		parts := strings.SplitN(r.Header.Get(FlashbotsSignatureHeader), ":", 2)
+		signature := common.FromHex(parts[len(parts)-1])
+		pub, err := crypto.SigToPub(pk.PersonalMessageHash([]byte(crypto.Keccak256Hash(body).Hex())), signature)
+		if len(parts) != 2 || err != nil || crypto.PubkeyToAddress(*pub) != common.HexToAddress(parts[0]) || common.HexToAddress(parts[0]) != crypto.PubkeyToAddress(authKey.PublicKey) {
+			authFailures++
+		}
+		relayServer.ServeHTTP(w, r)
+	}))
+	defer relayHTTP.Close()
+
+	private, err := pk.TransformPkToEvmSigner(hex.EncodeToString(crypto.Keccak256([]byte("private"))))
+	assert.Nil(t, err)
+	private.PrivateRelay = &clientModel.ConfEvmPrivateRelay{Provider: "FlashBots", TransportURL: relayHTTP.URL, AuthPrivateKey: authKey}
+	public, err := pk.TransformPkToEvmSigner(hex.EncodeToString(crypto.Keccak256([]byte("public"))))
+	assert.Nil(t, err)
+	ec := _testEvmClient(t, map[string]interface{}{"eth": publicService}, &clientModel.ConfEvmChainClient{
+		GasFeeRate: decimal.NewFromInt(1),
+		Signers:    []*clientModel.ConfEvmChainSigner{private, public},
+	})
+	to := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+	t.Run("AuthKey", func(t *testing.T) {
+		_, err := NewPrivateRelay(&clientModel.ConfEvmPrivateRelay{Provider: "FlashBots", TransportURL: relayHTTP.URL})
+		assert.ErrorIs(t, err, ErrPrivateRelayAuthKey)
+		relay, err := NewPrivateRelay(&clientModel.ConfEvmPrivateRelay{Provider: "MevBlocker", TransportURL: relayHTTP.URL})
+		assert.Nil(t, err)
+		defer relay.Close()
+		assert.NotEqual(t, common.Address{}, relay.AuthAddress())
+	})
+	t.Run("SendTransaction", func(t *testing.T) {
+		tx, err := ec.SendTransactionSimple(testCtx, private.PublicAddress, to, big.NewInt(1))
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(relayService.private))
+		assert.Equal(t, 0, len(publicService.sent))
+		raw, err := tx.MarshalBinary()
+		assert.Nil(t, err)
+		assert.Equal(t, hexutil.Bytes(raw), relayService.private[0])
+		assert.Equal(t, int64(125), relayService.maxBlockNumber.ToInt().Int64())
+
+		_, err = ec.SendTransactionSimple(testCtx, public.PublicAddress, to, big.NewInt(1))
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(relayService.private))
+		assert.Equal(t, 1, len(publicService.sent))
+
+		_, err = ec.SendPrivateTransaction(testCtx, publicService.sent[0])
+		assert.ErrorIs(t, err, ErrPrivateRelayNotConfig)
+	})
+	t.Run("ContractWrites", func(t *testing.T) {
+		relayed, sent := len(relayService.private), len(publicService.sent)
+		token := common.HexToAddress("0x3000000000000000000000000000000000000003")
+		_, err := ec.ERC20Transfer(testCtx, token, private.PublicAddress, to, big.NewInt(1))
+		assert.Nil(t, err)
+		_, err = ec.ERC20Approve(testCtx, token, private.PublicAddress, to, big.NewInt(1))
+		assert.Nil(t, err)
+		_, _, err = ec.DeployContract(testCtx, private.PublicAddress, abi.ABI{}, common.FromHex("0x6080"))
+		assert.Nil(t, err)
+		assert.Equal(t, relayed+3, len(relayService.private))
+		assert.Equal(t, sent, len(publicService.sent))
+	})
+	t.Run("Bundle", func(t *testing.T) {
+		tx, err := ec.SendTransactionSimple(testCtx, private.PublicAddress, to, big.NewInt(1))
+		assert.Nil(t, err)
+		raw, err := tx.MarshalBinary()
+		assert.Nil(t, err)
+
+		result, err := ec.CallBundle(testCtx, []*types.Transaction{tx}, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, crypto.Keccak256Hash(raw), result.BundleHash)
+		assert.Equal(t, int64(21e12), result.CoinbaseDiff.Int64())
+		assert.Equal(t, uint64(21000), result.TotalGasUsed)
+		assert.Equal(t, int64(1e9), result.Results[0].GasPrice.Int64())
+
+		bundleHash, err := ec.SendBundle(testCtx, &Bundle{Txs: []*types.Transaction{tx}})
+		assert.Nil(t, err)
+		assert.Equal(t, crypto.Keccak256Hash(raw), bundleHash)
+		assert.Equal(t, int64(101), relayService.bundleBlock.ToInt().Int64())
+
+		_, err = ec.SendBundle(testCtx, &Bundle{})
+		assert.ErrorIs(t, err, ErrBundleEmpty)
+		assert.Equal(t, 0, authFailures)
+	})
+}
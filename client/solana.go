@@ -5,11 +5,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"strings"
 	"time"
 
 	clientModel "github.com/6boris/web3-go/model/client"
 	"github.com/6boris/web3-go/model/solana"
+	"github.com/6boris/web3-go/pkg/units"
 	"github.com/google/uuid"
 	"github.com/imroc/req/v3"
 	"github.com/shopspring/decimal"
@@ -124,12 +126,22 @@ func (sc *SolanaClient) GetBalance(ctx context.Context, request *solana.GetBalan
 	if err != nil {
 		return nil, err
 	}
-	reply.Value, err = decimal.NewFromString(gjson.GetBytes(response.Bytes(), "result.value").String())
+	lamports, ok := new(big.Int).SetString(gjson.GetBytes(response.Bytes(), "result.value").String(), 10)
+	if !ok {
+		return nil, errors.New("invalid balance value")
+	}
+	reply.Lamports = lamports
+	reply.Value = units.LamportsToSol(lamports)
+	return reply, nil
+}
+
+// GetBalanceAmount returns the balance of account as a SOL TokenAmount.
+func (sc *SolanaClient) GetBalanceAmount(ctx context.Context, account string) (*units.TokenAmount, error) {
+	reply, err := sc.GetBalance(ctx, &solana.GetBalanceRequest{Account: account})
 	if err != nil {
 		return nil, err
 	}
-	reply.Value = reply.Value.Div(decimal.New(1, 9))
-	return reply, nil
+	return units.NewTokenAmount(reply.Lamports, &clientModel.TokenMetadata{Name: "Solana", Symbol: "SOL", Decimals: uint8(units.SolDecimals)}), nil
 }
 func (sc *SolanaClient) GetTokenAccountBalance(ctx context.Context, request *solana.GetTokenAccountBalanceRequest) (*solana.GetTokenAccountBalanceReply, error) {
 	reply := &solana.GetTokenAccountBalanceReply{}
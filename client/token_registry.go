@@ -0,0 +1,203 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/6boris/web3-go/erc/erc20"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var ErrEvmClientNotFound = errors.New("evm client not found")
+
+// TokenRegistry caches ERC-20 metadata per (chain, address). Name, symbol and decimals are immutable
+// for practically every token, so entries never expire.
+type TokenRegistry struct {
+	mu      sync.RWMutex
+	tokens  map[int64]map[common.Address]*clientModel.TokenMetadata
+	symbols map[int64]map[string][]common.Address
+}
+
+func NewTokenRegistry() *TokenRegistry {
+	return &TokenRegistry{
+		tokens:  make(map[int64]map[common.Address]*clientModel.TokenMetadata),
+		symbols: make(map[int64]map[string][]common.Address),
+	}
+}
+
+func (r *TokenRegistry) Get(chainID int64, address common.Address) (*clientModel.TokenMetadata, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	token, ok := r.tokens[chainID][address]
+	return token, ok
+}
+
+// Set adds or replaces token, keyed by its ChainID and Address.
+func (r *TokenRegistry) Set(token *clientModel.TokenMetadata) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.tokens[token.ChainID]; !ok {
+		r.tokens[token.ChainID] = make(map[common.Address]*clientModel.TokenMetadata)
+		r.symbols[token.ChainID] = make(map[string][]common.Address)
+	}
+	if old, ok := r.tokens[token.ChainID][token.Address]; ok {
+		r._removeSymbol(old)
+	}
+	r.tokens[token.ChainID][token.Address] = token
+	symbol := strings.ToUpper(token.Symbol)
+	r.symbols[token.ChainID][symbol] = append(r.symbols[token.ChainID][symbol], token.Address)
+}
+
+// LookupSymbol returns the tokens of chainID with symbol, case-insensitive. Symbols are not unique,
+// several tokens may be returned in insertion order.
+func (r *TokenRegistry) LookupSymbol(chainID int64, symbol string) []*clientModel.TokenMetadata {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	data := make([]*clientModel.TokenMetadata, 0)
+	for _, v := range r.symbols[chainID][strings.ToUpper(symbol)] {
+		data = append(data, r.tokens[chainID][v])
+	}
+	return data
+}
+
+// LoadTokenList seeds the registry from a Uniswap-style token list and returns the number of tokens added.
+func (r *TokenRegistry) LoadTokenList(reader io.Reader) (int, error) {
+	list := &clientModel.TokenList{}
+	if err := json.NewDecoder(reader).Decode(list); err != nil {
+		return 0, err
+	}
+	for _, v := range list.Tokens {
+		r.Set(v)
+	}
+	return len(list.Tokens), nil
+}
+func (r *TokenRegistry) LoadTokenListFile(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	return r.LoadTokenList(f)
+}
+
+func (r *TokenRegistry) _removeSymbol(token *clientModel.TokenMetadata) {
+	symbol := strings.ToUpper(token.Symbol)
+	addresses := r.symbols[token.ChainID][symbol]
+	for i, v := range addresses {
+		if v == token.Address {
+			r.symbols[token.ChainID][symbol] = append(addresses[:i:i], addresses[i+1:]...)
+			break
+		}
+	}
+}
+
+// ERC20Metadata reads name, symbol and decimals of token. Clients of a Pool look the token up in the
+// pool token registry first and cache what they read.
+func (ec *EvmClient) ERC20Metadata(ctx context.Context, token common.Address) (*clientModel.TokenMetadata, error) {
+	if ec._tokenCache != nil {
+		if data, ok := ec._tokenCache.Get(ec._ethChainID, token); ok {
+			return data, nil
+		}
+	}
+	name, err := ec._erc20String(ctx, token, "name", nil)
+	if err != nil {
+		return nil, err
+	}
+	symbol, err := ec._erc20String(ctx, token, "symbol", nil)
+	if err != nil {
+		return nil, err
+	}
+	decimals, err := ec._erc20Decimals(ctx, token, nil)
+	if err != nil {
+		return nil, err
+	}
+	data := &clientModel.TokenMetadata{
+		ChainID:  ec._ethChainID,
+		Address:  token,
+		Name:     name,
+		Symbol:   symbol,
+		Decimals: decimals,
+	}
+	if ec._tokenCache != nil {
+		ec._tokenCache.Set(data)
+	}
+	return data, nil
+}
+
+// _cachedTokenMetadata serves ERC20Name, ERC20Symbol and ERC20Decimals from the token registry, filling
+// it on a miss. Tokens whose metadata cannot be read as a whole are read field by field, uncached.
+func (ec *EvmClient) _cachedTokenMetadata(ctx context.Context, token common.Address) (*clientModel.TokenMetadata, bool) {
+	if ec._tokenCache == nil {
+		return nil, false
+	}
+	data, err := ec.ERC20Metadata(ctx, token)
+	return data, err == nil
+}
+
+// _erc20String calls a string getter of token, falling back to bytes32 for tokens such as MKR
+// that predate the string return type.
+func (ec *EvmClient) _erc20String(ctx context.Context, token common.Address, method string, blockNumber *big.Int) (string, error) {
+	erc20Abi, err := erc20.ERC20MetaData.GetAbi()
+	if err != nil {
+		return "", err
+	}
+	abiData, err := erc20Abi.Pack(method)
+	if err != nil {
+		return "", err
+	}
+	output, err := ec.ethClient.CallContract(ctx, ethereum.CallMsg{To: &token, Data: abiData}, blockNumber)
+	if err != nil {
+		return "", err
+	}
+	if len(output) == 32 {
+		return string(bytes.TrimRight(output, "\x00")), nil
+	}
+	values, err := erc20Abi.Methods[method].Outputs.Unpack(output)
+	if err != nil {
+		return "", err
+	}
+	return values[0].(string), nil
+}
+
+func (ec *EvmClient) _erc20Decimals(ctx context.Context, token common.Address, blockNumber *big.Int) (uint8, error) {
+	inst, err := erc20.NewERC20(token, ec.ethClient)
+	if err != nil {
+		return 0, err
+	}
+	return inst.Decimals(&bind.CallOpts{Context: ctx, BlockNumber: blockNumber})
+}
+
+func (p *Pool) TokenRegistry() *TokenRegistry {
+	return p._tokenRegistry
+}
+
+// GetTokenMetadata returns the cached metadata of token on chainID, reading and caching it on a miss.
+func (p *Pool) GetTokenMetadata(ctx context.Context, chainID int64, token common.Address) (*clientModel.TokenMetadata, error) {
+	if data, ok := p._tokenRegistry.Get(chainID, token); ok {
+		return data, nil
+	}
+	ec := p.GetEvmClient(chainID)
+	if ec == nil {
+		return nil, ErrEvmClientNotFound
+	}
+	data, err := ec.ERC20Metadata(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	data.ChainID = chainID
+	p._tokenRegistry.Set(data)
+	return data, nil
+}
+func (p *Pool) GetTokensBySymbol(chainID int64, symbol string) []*clientModel.TokenMetadata {
+	return p._tokenRegistry.LookupSymbol(chainID, symbol)
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/6boris/web3-go/erc/erc20"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// testTokenService answers eth_call like MKR, whose name and symbol are bytes32.
+type testTokenService struct {
+	calls int
+}
+
+func (s *testTokenService) Call(args map[string]interface{}, block string) (hexutil.Bytes, error) {
+	_ = block
+	s.calls++
+	erc20Abi, err := erc20.ERC20MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	input, _ := args["input"].(string)
+	data := common.FromHex(input)
+	switch {
+	case len(data) >= 4 && string(data[:4]) == string(erc20Abi.Methods["name"].ID):
+		return erc20Abi.Methods["name"].Outputs.Pack("Maker")
+	case len(data) >= 4 && string(data[:4]) == string(erc20Abi.Methods["symbol"].ID):
+		return common.RightPadBytes([]byte("MKR"), 32), nil
+	case len(data) >= 4 && string(data[:4]) == string(erc20Abi.Methods["decimals"].ID):
+		return erc20Abi.Methods["decimals"].Outputs.Pack(uint8(18))
+	}
+	return nil, errors.New("unsupported call")
+}
+
+func TestEvmClient_Unite_TokenRegistry(t *testing.T) {
+	service := &testTokenService{}
+	ec := _testEvmClient(t, map[string]interface{}{"eth": service}, nil)
+	pool := &Pool{
+		_evmClients:    map[int64]map[string]*EvmClient{1: {ec._clientID: ec}},
+		_tokenRegistry: NewTokenRegistry(),
+	}
+	mkr := common.HexToAddress("0x9f8F72aA9304c8B593d555F12eF6589cC3A579A2")
+
+	t.Run("Bytes32Symbol", func(t *testing.T) {
+		symbol, err := ec.ERC20Symbol(testCtx, mkr)
+		assert.Nil(t, err)
+		assert.Equal(t, "MKR", symbol)
+		name, err := ec.ERC20Name(testCtx, mkr)
+		assert.Nil(t, err)
+		assert.Equal(t, "Maker", name)
+	})
+	t.Run("GetTokenMetadata", func(t *testing.T) {
+		data, err := pool.GetTokenMetadata(testCtx, 1, mkr)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), data.ChainID)
+		assert.Equal(t, uint8(18), data.Decimals)
+		calls := service.calls
+		cached, err := pool.GetTokenMetadata(testCtx, 1, mkr)
+		assert.Nil(t, err)
+		assert.Equal(t, data, cached)
+		assert.Equal(t, calls, service.calls)
+		assert.Equal(t, []*clientModel.TokenMetadata{data}, pool.GetTokensBySymbol(1, "mkr"))
+
+		_, err = pool.GetTokenMetadata(testCtx, 56, mkr)
+		assert.ErrorIs(t, err, ErrEvmClientNotFound)
+	})
+	t.Run("ClientCache", func(t *testing.T) {
+		ec._ethChainID, ec._tokenCache = 1, NewTokenRegistry()
+		defer func() {
+			ec._ethChainID, ec._tokenCache = 0, nil
+		}()
+		decimals, err := ec.ERC20Decimals(testCtx, mkr)
+		assert.Nil(t, err)
+		assert.Equal(t, uint8(18), decimals)
+		calls := service.calls
+		name, err := ec.ERC20Name(testCtx, mkr)
+		assert.Nil(t, err)
+		assert.Equal(t, "Maker", name)
+		symbol, err := ec.ERC20Symbol(testCtx, mkr)
+		assert.Nil(t, err)
+		assert.Equal(t, "MKR", symbol)
+		decimals, err = ec.ERC20Decimals(testCtx, mkr)
+		assert.Nil(t, err)
+		assert.Equal(t, uint8(18), decimals)
+		assert.Equal(t, calls, service.calls)
+		_, ok := ec._tokenCache.Get(1, mkr)
+		assert.True(t, ok)
+	})
+	t.Run("LoadTokenList", func(t *testing.T) {
+		registry := NewTokenRegistry()
+		count, err := registry.LoadTokenList(strings.NewReader(`{
+			"name": "web3-go", "timestamp": "2024-01-01T00:00:00.000Z", "version": {"major": 1, "minor": 0, "patch": 0},
+			"tokens": [
+				{"chainId": 1, "address": "0xdAC17F958D2ee523a2206206994597C13D831ec7", "name": "Tether USD", "symbol": "USDT", "decimals": 6},
+				{"chainId": 137, "address": "0xc2132D05D31c914a87C6611C10748AEb04B58e8F", "name": "(PoS) Tether USD", "symbol": "USDT", "decimals": 6}
+			]
+		}`))
+		assert.Nil(t, err)
+		assert.Equal(t, 2, count)
+		tokens := registry.LookupSymbol(137, "USDT")
+		assert.Equal(t, 1, len(tokens))
+		assert.Equal(t, common.HexToAddress("0xc2132D05D31c914a87C6611C10748AEb04B58e8F"), tokens[0].Address)
+		token, ok := registry.Get(1, common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7"))
+		assert.True(t, ok)
+		assert.Equal(t, uint8(6), token.Decimals)
+
+		renamed := *token
+		renamed.Symbol = "USDT0"
+		registry.Set(&renamed)
+		assert.Equal(t, 0, len(registry.LookupSymbol(1, "USDT")))
+		assert.Equal(t, 1, len(registry.LookupSymbol(1, "USDT0")))
+		assert.Equal(t, 0, len(registry.LookupSymbol(56, "USDT")))
+	})
+}
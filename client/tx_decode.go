@@ -0,0 +1,138 @@
+package client
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/6boris/web3-go/erc/erc1155"
+	"github.com/6boris/web3-go/erc/erc20"
+	"github.com/6boris/web3-go/erc/erc2612"
+	"github.com/6boris/web3-go/erc/erc4626"
+	"github.com/6boris/web3-go/erc/erc721"
+	"github.com/6boris/web3-go/erc/permit2"
+	"github.com/6boris/web3-go/erc/safe"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// _knownAbis are tried in order when decoding calldata, the first ABI with the selector wins. ERC-20
+// comes before ERC-721 so the shared transferFrom selector decodes as ERC-20.
+var _knownAbis = []struct {
+	name string
+	meta *bind.MetaData
+}{
+	{"ERC-20", erc20.ERC20MetaData},
+	{"ERC-2612", erc2612.ERC2612MetaData},
+	{"ERC-721", erc721.ERC721MetaData},
+	{"ERC-1155", erc1155.ERC1155MetaData},
+	{"ERC-4626", erc4626.ERC4626MetaData},
+	{"Permit2", permit2.Permit2MetaData},
+	{"Safe", safe.SafeMetaData},
+	{"MultiSend", safe.MultiSendMetaData},
+}
+
+var _txTypeNames = map[uint8]string{
+	types.LegacyTxType:     "legacy",
+	types.AccessListTxType: "access_list",
+	types.DynamicFeeTxType: "dynamic_fee",
+	types.BlobTxType:       "blob",
+}
+
+type DecodedTransaction struct {
+	Tx       *types.Transaction
+	TypeName string
+	Hash     common.Hash
+	ChainID  *big.Int
+	From     common.Address
+	// Call is nil for plain transfers, contract creations and unknown selectors.
+	Call     *DecodedCall
+	Warnings []string
+}
+
+type DecodedCall struct {
+	Standard  string
+	Selector  string
+	Signature string
+	Args      map[string]interface{}
+}
+
+// DecodeRawTransaction decodes a signed transaction of any type from its raw hex, blob transactions may
+// carry their sidecar. It recovers the sender and decodes calldata against the ABIs bundled in erc.
+func DecodeRawTransaction(rawHex string) (*DecodedTransaction, error) {
+	raw, err := hexutil.Decode(_with0x(strings.TrimSpace(rawHex)))
+	if err != nil {
+		return nil, err
+	}
+	tx := new(types.Transaction)
+	if err = tx.UnmarshalBinary(raw); err != nil {
+		return nil, err
+	}
+	result := &DecodedTransaction{
+		Tx:       tx,
+		TypeName: _txTypeNames[tx.Type()],
+		Hash:     tx.Hash(),
+		ChainID:  tx.ChainId(),
+	}
+	var txSigner types.Signer = types.HomesteadSigner{}
+	if tx.Protected() {
+		txSigner = types.LatestSignerForChainID(tx.ChainId())
+	} else {
+		result.ChainID = nil
+		result.Warnings = append(result.Warnings, "legacy transaction without EIP-155 replay protection, valid on every chain")
+	}
+	if result.From, err = types.Sender(txSigner, tx); err != nil {
+		return nil, err
+	}
+	if tx.To() != nil && len(tx.Data()) >= 4 {
+		result.Call = _decodeKnownCall(tx.Data())
+	}
+	if result.Call != nil && result.Call.Signature == "approve(address,uint256)" {
+		if amount, ok := result.Call.Args["value"].(*big.Int); ok && amount.Cmp(math.MaxBig256) == 0 {
+			result.Warnings = append(result.Warnings, "unlimited ERC-20 approval")
+		}
+	}
+	return result, nil
+}
+
+// DecodeRawTransaction decodes rawHex like DecodeRawTransaction and flags a chain ID that has no
+// configured client in the pool.
+func (p *Pool) DecodeRawTransaction(rawHex string) (*DecodedTransaction, error) {
+	result, err := DecodeRawTransaction(rawHex)
+	if err != nil {
+		return nil, err
+	}
+	if result.ChainID != nil && len(p._evmClients[result.ChainID.Int64()]) == 0 {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("chain id %s is not configured in the pool", result.ChainID))
+	}
+	return result, nil
+}
+
+func _decodeKnownCall(data []byte) *DecodedCall {
+	for _, known := range _knownAbis {
+		contractAbi, err := known.meta.GetAbi()
+		if err != nil {
+			continue
+		}
+		method, err := contractAbi.MethodById(data[:4])
+		if err != nil {
+			continue
+		}
+		args := map[string]interface{}{}
+		if err = method.Inputs.UnpackIntoMap(args, data[4:]); err != nil {
+			continue
+		}
+		return &DecodedCall{Standard: known.name, Selector: hexutil.Encode(data[:4]), Signature: method.Sig, Args: args}
+	}
+	return nil
+}
+
+func _with0x(v string) string {
+	if strings.HasPrefix(v, "0x") || strings.HasPrefix(v, "0X") {
+		return v
+	}
+	return "0x" + v
+}
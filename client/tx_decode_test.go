@@ -0,0 +1,94 @@
+package client
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/6boris/web3-go/erc/erc20"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeRawTransaction_Unite(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	assert.Nil(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	token := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	to := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	erc20Abi, err := erc20.ERC20MetaData.GetAbi()
+	assert.Nil(t, err)
+	transfer, err := erc20Abi.Pack("transfer", to, big.NewInt(1e6))
+	assert.Nil(t, err)
+	encode := func(tx *types.Transaction, signer types.Signer) (string, *types.Transaction) {
+		signed, err := types.SignTx(tx, signer, key)
+		assert.Nil(t, err)
+		raw, err := signed.MarshalBinary()
+		assert.Nil(t, err)
+		return hexutil.Encode(raw), signed
+	}
+	chainID := big.NewInt(1)
+	latest := types.LatestSignerForChainID(chainID)
+
+	t.Run("Types", func(t *testing.T) {
+		sidecar, err := NewBlobTxSidecar([]byte("blob"))
+		assert.Nil(t, err)
+		cases := map[string]*types.Transaction{
+			"legacy":      types.NewTx(&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1e9), Gas: 60000, To: &token, Data: transfer}),
+			"access_list": types.NewTx(&types.AccessListTx{ChainID: chainID, Nonce: 1, GasPrice: big.NewInt(1e9), Gas: 60000, To: &token, Data: transfer}),
+			"dynamic_fee": types.NewTx(&types.DynamicFeeTx{ChainID: chainID, Nonce: 1, GasTipCap: big.NewInt(1e8), GasFeeCap: big.NewInt(1e9), Gas: 60000, To: &token, Data: transfer}),
+			"blob": types.NewTx(&types.BlobTx{
+				ChainID: uint256.NewInt(1), Nonce: 1, GasTipCap: uint256.NewInt(1e8), GasFeeCap: uint256.NewInt(1e9), Gas: 60000, To: token,
+				Value: new(uint256.Int), Data: transfer, BlobFeeCap: uint256.NewInt(1), BlobHashes: sidecar.BlobHashes(), Sidecar: sidecar,
+			}),
+		}
+		for typeName, tx := range cases {
+			rawHex, signed := encode(tx, latest)
+			decoded, err := DecodeRawTransaction(rawHex)
+			assert.Nil(t, err)
+			assert.Equal(t, typeName, decoded.TypeName)
+			assert.Equal(t, signed.Hash(), decoded.Hash)
+			assert.Equal(t, from, decoded.From)
+			assert.Equal(t, int64(1), decoded.ChainID.Int64())
+			assert.Empty(t, decoded.Warnings)
+			assert.Equal(t, "ERC-20", decoded.Call.Standard)
+			assert.Equal(t, "0xa9059cbb", decoded.Call.Selector)
+			assert.Equal(t, "transfer(address,uint256)", decoded.Call.Signature)
+			assert.Equal(t, to, decoded.Call.Args["to"])
+			assert.Equal(t, int64(1e6), decoded.Call.Args["value"].(*big.Int).Int64())
+		}
+	})
+	t.Run("Warnings", func(t *testing.T) {
+		approve, err := erc20Abi.Pack("approve", to, math.MaxBig256)
+		assert.Nil(t, err)
+		rawHex, _ := encode(types.NewTx(&types.LegacyTx{GasPrice: big.NewInt(1e9), Gas: 60000, To: &token, Data: approve}), types.HomesteadSigner{})
+		decoded, err := DecodeRawTransaction(rawHex[2:])
+		assert.Nil(t, err)
+		assert.Nil(t, decoded.ChainID)
+		assert.Equal(t, from, decoded.From)
+		assert.Equal(t, 2, len(decoded.Warnings))
+
+		_, err = DecodeRawTransaction("0x02f8")
+		assert.NotNil(t, err)
+	})
+	t.Run("Pool", func(t *testing.T) {
+		pool := NewPool(&clientModel.ConfPool{EvmChains: map[int64]*clientModel.ConfEvmChainInfo{
+			1: {ChainID: 1, Clients: []*clientModel.ConfEvmChainClient{{TransportSchema: "https", TransportURL: "http://127.0.0.1:8545"}}},
+		}})
+		rawHex, _ := encode(types.NewTx(&types.DynamicFeeTx{ChainID: chainID, GasFeeCap: big.NewInt(1e9), Gas: 21000, To: &to}), latest)
+		decoded, err := pool.DecodeRawTransaction(rawHex)
+		assert.Nil(t, err)
+		assert.Empty(t, decoded.Warnings)
+		assert.Nil(t, decoded.Call)
+
+		rawHex, _ = encode(types.NewTx(&types.DynamicFeeTx{ChainID: big.NewInt(5), GasFeeCap: big.NewInt(1e9), Gas: 21000, To: &to}), types.LatestSignerForChainID(big.NewInt(5)))
+		decoded, err = pool.DecodeRawTransaction(rawHex)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"chain id 5 is not configured in the pool"}, decoded.Warnings)
+	})
+}
@@ -39,6 +39,89 @@ const (
 	EvmErc20MethodIncreaseAllowance  = "EVM_ERC20_IncreaseAllowance"
 	EvmErc20MethodDecreaseAllowance  = "EVM_ERC20_DecreaseAllowance"
 	EvmErc20MethodAllowance          = "EVM_ERC20_Allowance"
+	EvmMethodPersonalSign            = "EVM_PersonalSign"
+	EvmMethodSignTypedData           = "EVM_SignTypedData"
+	EvmMethodVerifySignature         = "EVM_VerifySignature"
+	EvmErc1271MethodIsValidSignature = "EVM_ERC1271_IsValidSignature"
+	EvmMethodDeployContract          = "EVM_DeployContract"
+	EvmMethodDeployContractCreate2   = "EVM_DeployContractCreate2"
+	EvmErc721MethodOwnerOf           = "EVM_ERC721_OwnerOf"
+	EvmErc721MethodBalanceOf         = "EVM_ERC721_BalanceOf"
+	EvmErc721MethodTokenURI          = "EVM_ERC721_TokenURI"
+	EvmErc721MethodSafeTransferFrom  = "EVM_ERC721_SafeTransferFrom"
+	EvmErc721MethodApprove           = "EVM_ERC721_Approve"
+	EvmErc721MethodSetApprovalForAll = "EVM_ERC721_SetApprovalForAll"
+	EvmErc721MethodSupportsInterface = "EVM_ERC721_SupportsInterface"
+
+	EvmErc1155MethodBalanceOf             = "EVM_ERC1155_BalanceOf"
+	EvmErc1155MethodBalanceOfBatch        = "EVM_ERC1155_BalanceOfBatch"
+	EvmErc1155MethodURI                   = "EVM_ERC1155_URI"
+	EvmErc1155MethodSafeTransferFrom      = "EVM_ERC1155_SafeTransferFrom"
+	EvmErc1155MethodSafeBatchTransferFrom = "EVM_ERC1155_SafeBatchTransferFrom"
+	EvmErc1155MethodSetApprovalForAll     = "EVM_ERC1155_SetApprovalForAll"
+
+	EvmErc2612MethodNonces             = "EVM_ERC2612_Nonces"
+	EvmErc2612MethodDomainSeparator    = "EVM_ERC2612_DomainSeparator"
+	EvmErc2612MethodSignPermit         = "EVM_ERC2612_SignPermit"
+	EvmErc2612MethodPermit             = "EVM_ERC2612_Permit"
+	EvmPermit2MethodAllowance          = "EVM_Permit2_Allowance"
+	EvmPermit2MethodSign               = "EVM_Permit2_Sign"
+	EvmPermit2MethodPermit             = "EVM_Permit2_Permit"
+	EvmPermit2MethodPermitTransferFrom = "EVM_Permit2_PermitTransferFrom"
+
+	EvmErc4626MethodAsset           = "EVM_ERC4626_Asset"
+	EvmErc4626MethodTotalAssets     = "EVM_ERC4626_TotalAssets"
+	EvmErc4626MethodConvertToShares = "EVM_ERC4626_ConvertToShares"
+	EvmErc4626MethodConvertToAssets = "EVM_ERC4626_ConvertToAssets"
+	EvmErc4626MethodPreviewDeposit  = "EVM_ERC4626_PreviewDeposit"
+	EvmErc4626MethodPreviewRedeem   = "EVM_ERC4626_PreviewRedeem"
+	EvmErc4626MethodDeposit         = "EVM_ERC4626_Deposit"
+	EvmErc4626MethodMint            = "EVM_ERC4626_Mint"
+	EvmErc4626MethodWithdraw        = "EVM_ERC4626_Withdraw"
+	EvmErc4626MethodRedeem          = "EVM_ERC4626_Redeem"
+
+	EvmMethodENSResolveName   = "EVM_ENS_ResolveName"
+	EvmMethodENSLookupAddress = "EVM_ENS_LookupAddress"
+
+	EvmMethodSimulate = "EVM_Simulate"
+
+	EvmMethodTraceTransaction = "EVM_TraceTransaction"
+	EvmMethodTraceCall        = "EVM_TraceCall"
+
+	EvmMethodGetProof         = "EVM_GetProof"
+	EvmMethodGetVerifiedProof = "EVM_GetVerifiedProof"
+
+	EvmMethodEstimateFee = "EVM_EstimateFee"
+
+	EvmBundlerMethodSupportedEntryPoints     = "EVM_Bundler_SupportedEntryPoints"
+	EvmBundlerMethodEstimateUserOperationGas = "EVM_Bundler_EstimateUserOperationGas"
+	EvmBundlerMethodSendUserOperation        = "EVM_Bundler_SendUserOperation"
+	EvmBundlerMethodGetUserOperationReceipt  = "EVM_Bundler_GetUserOperationReceipt"
+	EvmBundlerMethodSignUserOperation        = "EVM_Bundler_SignUserOperation"
+
+	EvmSafeMethodNonce           = "EVM_Safe_Nonce"
+	EvmSafeMethodGetOwners       = "EVM_Safe_GetOwners"
+	EvmSafeMethodGetThreshold    = "EVM_Safe_GetThreshold"
+	EvmSafeMethodSignTransaction = "EVM_Safe_SignTransaction"
+	EvmSafeMethodExecTransaction = "EVM_Safe_ExecTransaction"
+
+	EvmMethodSuggestBlobGasFeeCap = "EVM_SuggestBlobGasFeeCap"
+	EvmMethodBuildBlobTransaction = "EVM_BuildBlobTransaction"
+	EvmMethodSendBlobTransaction  = "EVM_SendBlobTransaction"
+
+	EvmMethodCreateAccessList = "EVM_CreateAccessList"
+
+	EvmTxPoolMethodContent     = "EVM_TxPool_Content"
+	EvmTxPoolMethodContentFrom = "EVM_TxPool_ContentFrom"
+	EvmTxPoolMethodInspect     = "EVM_TxPool_Inspect"
+	EvmTxPoolMethodStatus      = "EVM_TxPool_Status"
+	EvmTxPoolMethodDiagnose    = "EVM_TxPool_Diagnose"
+
+	EvmMethodSendPrivateTransaction = "EVM_SendPrivateTransaction"
+	EvmMethodSendBundle             = "EVM_SendBundle"
+	EvmMethodCallBundle             = "EVM_CallBundle"
+
+	EvmMethodBlockNumberByTimestamp = "EVM_BlockNumberByTimestamp"
 
 	SolanaMethodGetBalance             = "SOLANA_GetBalance"
 	SolanaMethodGetTokenAccountBalance = "SOLANA_GetTokenAccountBalance"
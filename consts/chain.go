@@ -5,3 +5,10 @@ const (
 	ChainEnvTestnet = "Testnet"
 	ChainEnvDevnet  = "Devnet"
 )
+
+// Chain families with their own fee model, see EvmClient.EstimateFee.
+const (
+	ChainFamilyEthereum = "ethereum"
+	ChainFamilyOptimism = "optimism"
+	ChainFamilyArbitrum = "arbitrum"
+)
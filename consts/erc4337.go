@@ -0,0 +1,6 @@
+package consts
+
+const (
+	EntryPointVersionV06 = "0.6"
+	EntryPointVersionV07 = "0.7"
+)
@@ -0,0 +1,11 @@
+package consts
+
+const (
+	PolicyRuleRecipientAllowlist = "RECIPIENT_ALLOWLIST"
+	PolicyRuleMethodAllowlist    = "METHOD_ALLOWLIST"
+	PolicyRuleNativeTxLimit      = "NATIVE_TX_LIMIT"
+	PolicyRuleNativeWindowLimit  = "NATIVE_WINDOW_LIMIT"
+	PolicyRuleTokenTxLimit       = "TOKEN_TX_LIMIT"
+	PolicyRuleTokenWindowLimit   = "TOKEN_WINDOW_LIMIT"
+	PolicyRuleSignature          = "SIGNATURE"
+)
@@ -0,0 +1,11 @@
+package consts
+
+const (
+	SignerTypePrivateKey = "PRIVATE_KEY"
+	SignerTypeKeystore   = "KEYSTORE"
+	SignerTypeClef       = "CLEF"
+	SignerTypeWeb3Signer = "WEB3SIGNER"
+)
+
+// PrivateRelayProviderFlashbots names a Flashbots relay, which builds searcher reputation for its auth key.
+const PrivateRelayProviderFlashbots = "FLASHBOTS"
@@ -0,0 +1,295 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package erc2612
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ERC2612MetaData contains all meta data concerning the ERC2612 contract.
+var ERC2612MetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"name\":\"DOMAIN_SEPARATOR\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"nonces\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"deadline\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"v\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"r\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}],\"name\":\"permit\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"version\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// ERC2612ABI is the input ABI used to generate the binding from.
+// Deprecated: Use ERC2612MetaData.ABI instead.
+var ERC2612ABI = ERC2612MetaData.ABI
+
+// ERC2612 is an auto generated Go binding around an Ethereum contract.
+type ERC2612 struct {
+	ERC2612Caller     // Read-only binding to the contract
+	ERC2612Transactor // Write-only binding to the contract
+	ERC2612Filterer   // Log filterer for contract events
+}
+
+// ERC2612Caller is an auto generated read-only Go binding around an Ethereum contract.
+type ERC2612Caller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC2612Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type ERC2612Transactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC2612Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ERC2612Filterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC2612Session is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ERC2612Session struct {
+	Contract     *ERC2612          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ERC2612CallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ERC2612CallerSession struct {
+	Contract *ERC2612Caller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// ERC2612TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ERC2612TransactorSession struct {
+	Contract     *ERC2612Transactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// ERC2612Raw is an auto generated low-level Go binding around an Ethereum contract.
+type ERC2612Raw struct {
+	Contract *ERC2612 // Generic contract binding to access the raw methods on
+}
+
+// ERC2612CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ERC2612CallerRaw struct {
+	Contract *ERC2612Caller // Generic read-only contract binding to access the raw methods on
+}
+
+// ERC2612TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ERC2612TransactorRaw struct {
+	Contract *ERC2612Transactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewERC2612 creates a new instance of ERC2612, bound to a specific deployed contract.
+func NewERC2612(address common.Address, backend bind.ContractBackend) (*ERC2612, error) {
+	contract, err := bindERC2612(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC2612{ERC2612Caller: ERC2612Caller{contract: contract}, ERC2612Transactor: ERC2612Transactor{contract: contract}, ERC2612Filterer: ERC2612Filterer{contract: contract}}, nil
+}
+
+// NewERC2612Caller creates a new read-only instance of ERC2612, bound to a specific deployed contract.
+func NewERC2612Caller(address common.Address, caller bind.ContractCaller) (*ERC2612Caller, error) {
+	contract, err := bindERC2612(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC2612Caller{contract: contract}, nil
+}
+
+// NewERC2612Transactor creates a new write-only instance of ERC2612, bound to a specific deployed contract.
+func NewERC2612Transactor(address common.Address, transactor bind.ContractTransactor) (*ERC2612Transactor, error) {
+	contract, err := bindERC2612(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC2612Transactor{contract: contract}, nil
+}
+
+// NewERC2612Filterer creates a new log filterer instance of ERC2612, bound to a specific deployed contract.
+func NewERC2612Filterer(address common.Address, filterer bind.ContractFilterer) (*ERC2612Filterer, error) {
+	contract, err := bindERC2612(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC2612Filterer{contract: contract}, nil
+}
+
+// bindERC2612 binds a generic wrapper to an already deployed contract.
+func bindERC2612(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ERC2612MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC2612 *ERC2612Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC2612.Contract.ERC2612Caller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC2612 *ERC2612Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC2612.Contract.ERC2612Transactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC2612 *ERC2612Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC2612.Contract.ERC2612Transactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC2612 *ERC2612CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC2612.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC2612 *ERC2612TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC2612.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC2612 *ERC2612TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC2612.Contract.contract.Transact(opts, method, params...)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_ERC2612 *ERC2612Caller) DOMAINSEPARATOR(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ERC2612.contract.Call(opts, &out, "DOMAIN_SEPARATOR")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_ERC2612 *ERC2612Session) DOMAINSEPARATOR() ([32]byte, error) {
+	return _ERC2612.Contract.DOMAINSEPARATOR(&_ERC2612.CallOpts)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_ERC2612 *ERC2612CallerSession) DOMAINSEPARATOR() ([32]byte, error) {
+	return _ERC2612.Contract.DOMAINSEPARATOR(&_ERC2612.CallOpts)
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_ERC2612 *ERC2612Caller) Nonces(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC2612.contract.Call(opts, &out, "nonces", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_ERC2612 *ERC2612Session) Nonces(owner common.Address) (*big.Int, error) {
+	return _ERC2612.Contract.Nonces(&_ERC2612.CallOpts, owner)
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_ERC2612 *ERC2612CallerSession) Nonces(owner common.Address) (*big.Int, error) {
+	return _ERC2612.Contract.Nonces(&_ERC2612.CallOpts, owner)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() view returns(string)
+func (_ERC2612 *ERC2612Caller) Version(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ERC2612.contract.Call(opts, &out, "version")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() view returns(string)
+func (_ERC2612 *ERC2612Session) Version() (string, error) {
+	return _ERC2612.Contract.Version(&_ERC2612.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() view returns(string)
+func (_ERC2612 *ERC2612CallerSession) Version() (string, error) {
+	return _ERC2612.Contract.Version(&_ERC2612.CallOpts)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0xd505accf.
+//
+// Solidity: function permit(address owner, address spender, uint256 value, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_ERC2612 *ERC2612Transactor) Permit(opts *bind.TransactOpts, owner common.Address, spender common.Address, value *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _ERC2612.contract.Transact(opts, "permit", owner, spender, value, deadline, v, r, s)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0xd505accf.
+//
+// Solidity: function permit(address owner, address spender, uint256 value, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_ERC2612 *ERC2612Session) Permit(owner common.Address, spender common.Address, value *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _ERC2612.Contract.Permit(&_ERC2612.TransactOpts, owner, spender, value, deadline, v, r, s)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0xd505accf.
+//
+// Solidity: function permit(address owner, address spender, uint256 value, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_ERC2612 *ERC2612TransactorSession) Permit(owner common.Address, spender common.Address, value *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _ERC2612.Contract.Permit(&_ERC2612.TransactOpts, owner, spender, value, deadline, v, r, s)
+}
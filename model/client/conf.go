@@ -2,6 +2,7 @@ package client
 
 import (
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -15,31 +16,93 @@ type ConfPool struct {
 	Cluster      string                      `yaml:"cluster" json:"cluster"`
 	EvmChains    map[int64]*ConfEvmChainInfo `yaml:"evm_chains" json:"evm_chains"`
 	SolanaChains []*ConfSolanaClient         `yaml:"solana_chains" json:"solana_chains"`
+	TokenLists   []string                    `yaml:"token_lists" json:"token_lists"`
+	// ResolveENS lets the gateway accept ENS names wherever an address is expected, resolved on chain 1.
+	ResolveENS bool `yaml:"resolve_ens" json:"resolve_ens"`
 }
 type ConfEvmChainInfo struct {
 	ChainID         int64                 `yaml:"chain_id" json:"chain_id"`
 	ChainName       string                `yaml:"chain_name" json:"chain_name"`
 	ChainEnv        string                `yaml:"chain_env" json:"chain_env"`
+	ChainFamily     string                `yaml:"chain_family" json:"chain_family"`
+	NativeSymbol    string                `yaml:"native_symbol" json:"native_symbol"`
 	OfficialWebsite string                `yaml:"official_website_url" json:"official_website"`
 	ExplorerURL     string                `yaml:"explorer_url" json:"explorer_url"`
 	Faucets         []string              `yaml:"faucets" json:"faucets"`
+	BroadcastFanout int                   `yaml:"broadcast_fanout" json:"broadcast_fanout"`
 	Clients         []*ConfEvmChainClient `yaml:"clients" json:"clients"`
+	Bundlers        []*ConfEvmBundler     `yaml:"bundlers" json:"bundlers"`
 }
 type ConfEvmChainClient struct {
-	ClientID        string                `yaml:"client_id" json:"client_id"`
-	Provider        string                `yaml:"provider" json:"provider"`
-	ProviderWebsite string                `yaml:"provider_website" json:"provider_website"`
-	TransportSchema string                `yaml:"transport_schema" json:"transport_schema"`
-	TransportURL    string                `yaml:"transport_url" json:"transport_url"`
-	GasFeeRate      decimal.Decimal       `yaml:"gas_fee_rate" json:"gas_fee_rate"`
-	GasLimitRate    decimal.Decimal       `yaml:"gas_limit_rate" json:"gas_limit_rate"`
-	GasLimitMax     decimal.Decimal       `yaml:"gas_limit_max" json:"gas_limit_max"`
-	Signers         []*ConfEvmChainSigner `yaml:"signers" json:"signers"`
+	ClientID        string          `yaml:"client_id" json:"client_id"`
+	Provider        string          `yaml:"provider" json:"provider"`
+	ProviderWebsite string          `yaml:"provider_website" json:"provider_website"`
+	TransportSchema string          `yaml:"transport_schema" json:"transport_schema"`
+	TransportURL    string          `yaml:"transport_url" json:"transport_url"`
+	GasFeeRate      decimal.Decimal `yaml:"gas_fee_rate" json:"gas_fee_rate"`
+	GasLimitRate    decimal.Decimal `yaml:"gas_limit_rate" json:"gas_limit_rate"`
+	GasLimitMax     decimal.Decimal `yaml:"gas_limit_max" json:"gas_limit_max"`
+	AutoAccessList  bool            `yaml:"auto_access_list" json:"auto_access_list"`
+	// ChainFamily selects the fee model, when empty it is derived from the chain ID.
+	ChainFamily string                `yaml:"chain_family" json:"chain_family"`
+	Signers     []*ConfEvmChainSigner `yaml:"signers" json:"signers"`
+}
+
+// ConfEvmBundler is an ERC-4337 bundler endpoint, EntryPoint defaults to the v0.7 EntryPoint. User operations
+// are signed by the signers of the first https client of the chain.
+type ConfEvmBundler struct {
+	ClientID          string         `yaml:"client_id" json:"client_id"`
+	Provider          string         `yaml:"provider" json:"provider"`
+	TransportURL      string         `yaml:"transport_url" json:"transport_url"`
+	EntryPoint        common.Address `yaml:"entry_point" json:"entry_point"`
+	EntryPointVersion string         `yaml:"entry_point_version" json:"entry_point_version"`
 }
 
 type ConfEvmChainSigner struct {
-	PublicAddress common.Address    `json:"public_address"`
-	PrivateKey    *ecdsa.PrivateKey `json:"-"`
+	SignerType         string               `yaml:"signer_type" json:"signer_type"`
+	PublicAddress      common.Address       `yaml:"public_address" json:"public_address"`
+	PrivateKey         *ecdsa.PrivateKey    `yaml:"-" json:"-"`
+	KeystorePath       string               `yaml:"keystore_path" json:"keystore_path"`
+	KeystorePassphrase string               `yaml:"-" json:"-"`
+	RemoteURL          string               `yaml:"remote_url" json:"remote_url"`
+	DerivationPath     string               `yaml:"derivation_path" json:"derivation_path"`
+	Policy             *ConfEvmSignerPolicy `yaml:"policy" json:"policy"`
+	PrivateRelay       *ConfEvmPrivateRelay `yaml:"private_relay" json:"private_relay"`
+}
+
+// ConfEvmPrivateRelay routes the transactions of a signer to a MEV protection relay such as Flashbots
+// Protect or MEV Blocker instead of the public mempool.
+type ConfEvmPrivateRelay struct {
+	Provider     string `yaml:"provider" json:"provider"`
+	TransportURL string `yaml:"transport_url" json:"transport_url"`
+	// AuthPrivateKey signs the X-Flashbots-Signature header. It is required for the Flashbots provider,
+	// whose reputation is bound to it, other relays get a random key per start when empty.
+	AuthPrivateKey *ecdsa.PrivateKey `yaml:"-" json:"-"`
+	// MaxBlocks bounds how many blocks a private transaction is retried for, 25 when zero.
+	MaxBlocks uint64 `yaml:"max_blocks" json:"max_blocks"`
+}
+type ConfEvmSignerPolicy struct {
+	DryRun             bool                                  `yaml:"dry_run" json:"dry_run"`
+	RecipientAllowlist []common.Address                      `yaml:"recipient_allowlist" json:"recipient_allowlist"`
+	MethodAllowlist    []*ConfEvmContractMethods             `yaml:"method_allowlist" json:"method_allowlist"`
+	NativeLimit        *ConfEvmValueLimit                    `yaml:"native_limit" json:"native_limit"`
+	TokenLimits        map[common.Address]*ConfEvmValueLimit `yaml:"token_limits" json:"token_limits"`
+}
+type ConfEvmContractMethods struct {
+	// Contract left empty matches any contract.
+	Contract common.Address `yaml:"contract" json:"contract"`
+	// Methods accepts signatures such as "transfer(address,uint256)" or 4 byte selectors such as "0xa9059cbb".
+	Methods []string `yaml:"methods" json:"methods"`
+}
+type ConfEvmValueLimit struct {
+	PerTx     decimal.Decimal `yaml:"per_tx" json:"per_tx"`
+	PerWindow decimal.Decimal `yaml:"per_window" json:"per_window"`
+	Window    time.Duration   `yaml:"window" json:"window"`
+}
+type ConfSolanaSigner struct {
+	PublicAddress  string             `yaml:"public_address" json:"public_address"`
+	PrivateKey     ed25519.PrivateKey `yaml:"-" json:"-"`
+	DerivationPath string             `yaml:"derivation_path" json:"derivation_path"`
 }
 type ConfEvmChain struct {
 	ChainID         int64         `yaml:"chain_id" json:"chain_id"`
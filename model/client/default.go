@@ -35,6 +35,7 @@ func GetDefaultConfPool() *ConfPool {
 				ChainID:         1,
 				ChainName:       "Ethereum Mainnet",
 				ChainEnv:        consts.ChainEnvMainnet,
+				NativeSymbol:    "ETH",
 				OfficialWebsite: "https://ethereum.org",
 				ExplorerURL:     "https://etherscan.io",
 				Faucets:         []string{},
@@ -62,6 +63,7 @@ func GetDefaultConfPool() *ConfPool {
 				ChainID:         11155111,
 				ChainName:       "Ethereum Sepolia",
 				ChainEnv:        consts.ChainEnvTestnet,
+				NativeSymbol:    "ETH",
 				OfficialWebsite: "https://ethereum.org",
 				ExplorerURL:     "https://sepolia.etherscan.io",
 				Faucets:         []string{},
@@ -78,6 +80,7 @@ func GetDefaultConfPool() *ConfPool {
 				ChainID:         5,
 				ChainName:       "Ethereum Goerli",
 				ChainEnv:        consts.ChainEnvTestnet,
+				NativeSymbol:    "ETH",
 				OfficialWebsite: "https://ethereum.org",
 				ExplorerURL:     "https://goerli.etherscan.io",
 				Faucets:         []string{},
@@ -95,6 +98,7 @@ func GetDefaultConfPool() *ConfPool {
 				ChainID:         137,
 				ChainName:       "Polygon PoS Chain",
 				ChainEnv:        consts.ChainEnvMainnet,
+				NativeSymbol:    "MATIC",
 				OfficialWebsite: "https://polygon.technology",
 				ExplorerURL:     "https://polygonscan.com",
 				Faucets:         []string{},
@@ -112,6 +116,7 @@ func GetDefaultConfPool() *ConfPool {
 				ChainID:         80001,
 				ChainName:       "Polygon PoS Chain Testnet",
 				ChainEnv:        consts.ChainEnvTestnet,
+				NativeSymbol:    "MATIC",
 				OfficialWebsite: "https://polygon.technology",
 				ExplorerURL:     "https://mumbai.polygonscan.com",
 				Faucets:         []string{},
@@ -127,6 +132,8 @@ func GetDefaultConfPool() *ConfPool {
 				ChainID:         10,
 				ChainName:       "Optimism",
 				ChainEnv:        consts.ChainEnvMainnet,
+				ChainFamily:     consts.ChainFamilyOptimism,
+				NativeSymbol:    "ETH",
 				OfficialWebsite: "https://www.optimism.io",
 				ExplorerURL:     "https://optimistic.etherscan.io",
 				Faucets:         []string{},
@@ -143,6 +150,8 @@ func GetDefaultConfPool() *ConfPool {
 				ChainID:         420,
 				ChainName:       "Optimism Goerli",
 				ChainEnv:        consts.ChainEnvTestnet,
+				ChainFamily:     consts.ChainFamilyOptimism,
+				NativeSymbol:    "ETH",
 				OfficialWebsite: "https://www.optimism.io",
 				ExplorerURL:     "https://goerli-explorer.optimism.io",
 				Faucets:         []string{},
@@ -157,6 +166,8 @@ func GetDefaultConfPool() *ConfPool {
 				ChainID:         42161,
 				ChainName:       "Arbitrum One",
 				ChainEnv:        consts.ChainEnvMainnet,
+				ChainFamily:     consts.ChainFamilyArbitrum,
+				NativeSymbol:    "ETH",
 				OfficialWebsite: "https://arbitrum.io",
 				ExplorerURL:     "https://arbiscan.io",
 				Faucets:         []string{},
@@ -170,6 +181,8 @@ func GetDefaultConfPool() *ConfPool {
 				ChainID:         42170,
 				ChainName:       "Arbitrum Nova",
 				ChainEnv:        consts.ChainEnvTestnet,
+				ChainFamily:     consts.ChainFamilyArbitrum,
+				NativeSymbol:    "ETH",
 				OfficialWebsite: "https://arbitrum.io",
 				ExplorerURL:     "https://nova.arbiscan.io",
 				Faucets:         []string{},
@@ -181,6 +194,8 @@ func GetDefaultConfPool() *ConfPool {
 				ChainID:         421613,
 				ChainName:       "Arbitrum Goerli",
 				ChainEnv:        consts.ChainEnvTestnet,
+				ChainFamily:     consts.ChainFamilyArbitrum,
+				NativeSymbol:    "ETH",
 				OfficialWebsite: "https://arbitrum.io",
 				ExplorerURL:     "https://goerli.arbiscan.io",
 				Faucets:         []string{},
@@ -192,6 +207,7 @@ func GetDefaultConfPool() *ConfPool {
 				ChainID:         43113,
 				ChainName:       "Avalanche Fuji",
 				ChainEnv:        consts.ChainEnvMainnet,
+				NativeSymbol:    "AVAX",
 				OfficialWebsite: "https://www.avax.network",
 				ExplorerURL:     "https://testnet.snowtrace.io",
 				Faucets:         []string{},
@@ -203,6 +219,7 @@ func GetDefaultConfPool() *ConfPool {
 				ChainID:         43114,
 				ChainName:       "Avalanche Fuji",
 				ChainEnv:        consts.ChainEnvTestnet,
+				NativeSymbol:    "AVAX",
 				OfficialWebsite: "https://www.avax.network",
 				ExplorerURL:     "https://testnet.snowtrace.io",
 				Faucets:         []string{},
@@ -215,6 +232,7 @@ func GetDefaultConfPool() *ConfPool {
 				ChainID:         100,
 				ChainName:       "Gnosis",
 				ChainEnv:        consts.ChainEnvMainnet,
+				NativeSymbol:    "XDAI",
 				OfficialWebsite: "https://www.gnosis.io",
 				ExplorerURL:     "https://testnet.snowtrace.io",
 				Faucets:         []string{},
@@ -226,6 +244,7 @@ func GetDefaultConfPool() *ConfPool {
 				ChainID:         56,
 				ChainName:       "BNB Smart Chain",
 				ChainEnv:        consts.ChainEnvMainnet,
+				NativeSymbol:    "BNB",
 				OfficialWebsite: "https://bscscan.com",
 				ExplorerURL:     "https://bscscan.com",
 				Faucets:         []string{},
@@ -237,6 +256,7 @@ func GetDefaultConfPool() *ConfPool {
 				ChainID:         97,
 				ChainName:       "BSC Testnet",
 				ChainEnv:        consts.ChainEnvTestnet,
+				NativeSymbol:    "BNB",
 				OfficialWebsite: "https://bscscan.com",
 				ExplorerURL:     "https://testnet.bscscan.com",
 				Faucets:         []string{},
@@ -248,6 +268,7 @@ func GetDefaultConfPool() *ConfPool {
 				ChainID:         250,
 				ChainName:       "Fantom Mainnet",
 				ChainEnv:        consts.ChainEnvMainnet,
+				NativeSymbol:    "FTM",
 				OfficialWebsite: "",
 				ExplorerURL:     "",
 				Faucets:         []string{},
@@ -259,6 +280,7 @@ func GetDefaultConfPool() *ConfPool {
 				ChainID:         4002,
 				ChainName:       "Fantom Testnet",
 				ChainEnv:        consts.ChainEnvTestnet,
+				NativeSymbol:    "FTM",
 				OfficialWebsite: "",
 				ExplorerURL:     "",
 				Faucets:         []string{},
@@ -0,0 +1,27 @@
+package client
+
+import "github.com/ethereum/go-ethereum/common"
+
+type TokenMetadata struct {
+	ChainID  int64          `yaml:"chain_id" json:"chainId"`
+	Address  common.Address `yaml:"address" json:"address"`
+	Name     string         `yaml:"name" json:"name"`
+	Symbol   string         `yaml:"symbol" json:"symbol"`
+	Decimals uint8          `yaml:"decimals" json:"decimals"`
+	LogoURI  string         `yaml:"logo_uri" json:"logoURI,omitempty"`
+}
+
+// TokenList follows the Uniswap token list schema, https://github.com/Uniswap/token-lists
+type TokenList struct {
+	Name      string           `json:"name"`
+	Timestamp string           `json:"timestamp"`
+	Version   TokenListVersion `json:"version"`
+	Keywords  []string         `json:"keywords,omitempty"`
+	LogoURI   string           `json:"logoURI,omitempty"`
+	Tokens    []*TokenMetadata `json:"tokens"`
+}
+type TokenListVersion struct {
+	Major int `json:"major"`
+	Minor int `json:"minor"`
+	Patch int `json:"patch"`
+}
@@ -1,6 +1,10 @@
 package solana
 
-import "github.com/shopspring/decimal"
+import (
+	"math/big"
+
+	"github.com/shopspring/decimal"
+)
 
 type ContextItem struct {
 	ApiVersion string `json:"apiVersion"`
@@ -33,8 +37,9 @@ type GetBalanceRequest struct {
 	Account string `json:"account"`
 }
 type GetBalanceReply struct {
-	Context *ContextItem    `json:"context"`
-	Value   decimal.Decimal `json:"value"`
+	Context  *ContextItem    `json:"context"`
+	Value    decimal.Decimal `json:"value"`
+	Lamports *big.Int        `json:"lamports"`
 }
 
 type GetTokenAccountBalanceRequest struct {
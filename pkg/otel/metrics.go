@@ -8,6 +8,7 @@ import (
 
 var MetricsWeb3RequestCounter otelMetrics.Int64Counter
 var MetricsWeb3RequestHistogram otelMetrics.Int64Histogram
+var MetricsWeb3PolicyDeniedCounter otelMetrics.Int64Counter
 
 func init() {
 	opts := []otelProm.Option{
@@ -29,6 +30,12 @@ func init() {
 		panic(err)
 	}
 
+	m3, err := meter.Int64Counter("web3_policy_denied", otelMetrics.WithDescription("Web3 Gateway signing policy denied counter"))
+	if err != nil {
+		panic(err)
+	}
+
 	MetricsWeb3RequestCounter = m1
 	MetricsWeb3RequestHistogram = m2
+	MetricsWeb3PolicyDeniedCounter = m3
 }
@@ -0,0 +1,234 @@
+package pk
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tyler-smith/go-bip39"
+)
+
+const (
+	DefaultEvmDerivationPath    = "m/44'/60'/0'/0/0"
+	DefaultSolanaDerivationPath = "m/44'/501'/0'/0'"
+
+	hardenedKeyStart = 0x80000000
+)
+
+// NewMnemonic generates a BIP-39 mnemonic, bitSize must be a multiple of 32 within [128, 256].
+func NewMnemonic(bitSize int) (string, error) {
+	entropy, err := bip39.NewEntropy(bitSize)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// MnemonicToSeed validates the mnemonic checksum and returns the BIP-39 seed.
+func MnemonicToSeed(mnemonic string, passphrase string) ([]byte, error) {
+	return bip39.NewSeedWithErrorChecking(mnemonic, passphrase)
+}
+
+// ExpandDerivationPath expands a path whose single ranged component is written as "start-end",
+// e.g. "m/44'/60'/0'/0/0-99" or "m/44'/501'/0'-9'/0'". Paths without a range are returned as is.
+func ExpandDerivationPath(path string) ([]string, error) {
+	components := strings.Split(path, "/")
+	rangeIndex := -1
+	for i, c := range components {
+		if strings.Contains(c, "-") {
+			if rangeIndex >= 0 {
+				return nil, fmt.Errorf("derivation path has more than one range: %s", path)
+			}
+			rangeIndex = i
+		}
+	}
+	if rangeIndex < 0 {
+		return []string{path}, nil
+	}
+	bounds := strings.SplitN(components[rangeIndex], "-", 2)
+	hardened := strings.HasSuffix(bounds[1], "'")
+	start, err := strconv.ParseUint(strings.TrimSuffix(bounds[0], "'"), 10, 31)
+	if err != nil {
+		return nil, fmt.Errorf("invalid derivation path range start: %s", path)
+	}
+	end, err := strconv.ParseUint(strings.TrimSuffix(bounds[1], "'"), 10, 31)
+	if err != nil || end < start {
+		return nil, fmt.Errorf("invalid derivation path range end: %s", path)
+	}
+	paths := make([]string, 0, end-start+1)
+	for i := start; i <= end; i++ {
+		loopComponents := append([]string{}, components...)
+		loopComponents[rangeIndex] = strconv.FormatUint(i, 10)
+		if hardened {
+			loopComponents[rangeIndex] += "'"
+		}
+		paths = append(paths, strings.Join(loopComponents, "/"))
+	}
+	return paths, nil
+}
+
+// DeriveEvmSigner derives a secp256k1 signer following BIP-32 / BIP-44.
+func DeriveEvmSigner(mnemonic string, passphrase string, path string) (*clientModel.ConfEvmChainSigner, error) {
+	seed, err := MnemonicToSeed(mnemonic, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return deriveEvmSignerFromSeed(seed, path)
+}
+
+// DeriveEvmSigners derives one signer per path in the (possibly ranged) derivation path.
+func DeriveEvmSigners(mnemonic string, passphrase string, path string) ([]*clientModel.ConfEvmChainSigner, error) {
+	seed, err := MnemonicToSeed(mnemonic, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	paths, err := ExpandDerivationPath(path)
+	if err != nil {
+		return nil, err
+	}
+	signers := make([]*clientModel.ConfEvmChainSigner, 0, len(paths))
+	for _, v := range paths {
+		signer, loopErr := deriveEvmSignerFromSeed(seed, v)
+		if loopErr != nil {
+			return nil, loopErr
+		}
+		signers = append(signers, signer)
+	}
+	return signers, nil
+}
+
+// DeriveSolanaSigner derives an ed25519 signer following SLIP-0010, every path component must be hardened.
+func DeriveSolanaSigner(mnemonic string, passphrase string, path string) (*clientModel.ConfSolanaSigner, error) {
+	seed, err := MnemonicToSeed(mnemonic, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return deriveSolanaSignerFromSeed(seed, path)
+}
+
+func DeriveSolanaSigners(mnemonic string, passphrase string, path string) ([]*clientModel.ConfSolanaSigner, error) {
+	seed, err := MnemonicToSeed(mnemonic, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	paths, err := ExpandDerivationPath(path)
+	if err != nil {
+		return nil, err
+	}
+	signers := make([]*clientModel.ConfSolanaSigner, 0, len(paths))
+	for _, v := range paths {
+		signer, loopErr := deriveSolanaSignerFromSeed(seed, v)
+		if loopErr != nil {
+			return nil, loopErr
+		}
+		signers = append(signers, signer)
+	}
+	return signers, nil
+}
+
+func deriveEvmSignerFromSeed(seed []byte, path string) (*clientModel.ConfEvmChainSigner, error) {
+	derivationPath, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		return nil, err
+	}
+	key, chainCode := hmacSHA512([]byte("Bitcoin seed"), seed)
+	curveN := crypto.S256().Params().N
+	k := new(big.Int).SetBytes(key)
+	if k.Sign() == 0 || k.Cmp(curveN) >= 0 {
+		return nil, errors.New("invalid master key")
+	}
+	for _, index := range derivationPath {
+		var data []byte
+		if index >= hardenedKeyStart {
+			data = append([]byte{0x00}, math.PaddedBigBytes(k, 32)...)
+		} else {
+			privateKey, loopErr := crypto.ToECDSA(math.PaddedBigBytes(k, 32))
+			if loopErr != nil {
+				return nil, loopErr
+			}
+			data = crypto.CompressPubkey(&privateKey.PublicKey)
+		}
+		data = binary.BigEndian.AppendUint32(data, index)
+		il, ir := hmacSHA512(chainCode, data)
+		ilInt := new(big.Int).SetBytes(il)
+		if ilInt.Cmp(curveN) >= 0 {
+			return nil, fmt.Errorf("invalid child key at index %d", index)
+		}
+		k = ilInt.Add(ilInt, k).Mod(ilInt, curveN)
+		if k.Sign() == 0 {
+			return nil, fmt.Errorf("invalid child key at index %d", index)
+		}
+		chainCode = ir
+	}
+	privateKey, err := crypto.ToECDSA(math.PaddedBigBytes(k, 32))
+	if err != nil {
+		return nil, err
+	}
+	return &clientModel.ConfEvmChainSigner{
+		PublicAddress:  crypto.PubkeyToAddress(privateKey.PublicKey),
+		PrivateKey:     privateKey,
+		DerivationPath: derivationPath.String(),
+	}, nil
+}
+
+func deriveSolanaSignerFromSeed(seed []byte, path string) (*clientModel.ConfSolanaSigner, error) {
+	derivationPath, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		return nil, err
+	}
+	key, chainCode := hmacSHA512([]byte("ed25519 seed"), seed)
+	for _, index := range derivationPath {
+		if index < hardenedKeyStart {
+			return nil, fmt.Errorf("ed25519 only supports hardened derivation: %s", path)
+		}
+		data := append([]byte{0x00}, key...)
+		data = binary.BigEndian.AppendUint32(data, index)
+		key, chainCode = hmacSHA512(chainCode, data)
+	}
+	privateKey := ed25519.NewKeyFromSeed(key)
+	return &clientModel.ConfSolanaSigner{
+		PublicAddress:  base58Encode(privateKey.Public().(ed25519.PublicKey)),
+		PrivateKey:     privateKey,
+		DerivationPath: derivationPath.String(),
+	}, nil
+}
+
+func hmacSHA512(key []byte, data []byte) ([]byte, []byte) {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+	return sum[:32], sum[32:]
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+func base58Encode(input []byte) string {
+	x := new(big.Int).SetBytes(input)
+	radix := big.NewInt(58)
+	mod := new(big.Int)
+	encoded := make([]byte, 0, len(input)*138/100+1)
+	for x.Sign() > 0 {
+		x.DivMod(x, radix, mod)
+		encoded = append(encoded, base58Alphabet[mod.Int64()])
+	}
+	for _, b := range input {
+		if b != 0 {
+			break
+		}
+		encoded = append(encoded, base58Alphabet[0])
+	}
+	for i, j := 0, len(encoded)-1; i < j; i, j = i+1, j-1 {
+		encoded[i], encoded[j] = encoded[j], encoded[i]
+	}
+	return string(encoded)
+}
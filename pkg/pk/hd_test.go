@@ -0,0 +1,61 @@
+package pk
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHD_Unite_Evm(t *testing.T) {
+	mnemonic := "test test test test test test test test test test test junk"
+	t.Run("DeriveEvmSigner", func(t *testing.T) {
+		signer, err := DeriveEvmSigner(mnemonic, "", DefaultEvmDerivationPath)
+		assert.Nil(t, err)
+		assert.Equal(t, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", signer.PublicAddress.String())
+		assert.Equal(t, "m/44'/60'/0'/0/0", signer.DerivationPath)
+	})
+	t.Run("DeriveEvmSigners", func(t *testing.T) {
+		signers, err := DeriveEvmSigners(mnemonic, "", "m/44'/60'/0'/0/0-2")
+		assert.Nil(t, err)
+		assert.Len(t, signers, 3)
+		assert.Equal(t, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", signers[0].PublicAddress.String())
+		assert.Equal(t, "0x70997970C51812dc3A010C7d01b50e0d17dc79C8", signers[1].PublicAddress.String())
+		assert.Equal(t, "0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC", signers[2].PublicAddress.String())
+	})
+	t.Run("Passphrase", func(t *testing.T) {
+		signer, err := DeriveEvmSigner(mnemonic, "web3-go", DefaultEvmDerivationPath)
+		assert.Nil(t, err)
+		assert.NotEqual(t, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", signer.PublicAddress.String())
+	})
+	t.Run("InvalidMnemonic", func(t *testing.T) {
+		_, err := DeriveEvmSigner("test test test test test test test test test test test test", "", DefaultEvmDerivationPath)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestHD_Unite_Solana(t *testing.T) {
+	t.Run("SLIP-0010 Vector 1", func(t *testing.T) {
+		seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+		signer, err := deriveSolanaSignerFromSeed(seed, "m/0'/1'/2'/2'/1000000000'")
+		assert.Nil(t, err)
+		assert.Equal(t, "8f94d394a8e8fd6b1bc2f3f49f5c47e385281d5c17e65324b0f62483e37e8793", hex.EncodeToString(signer.PrivateKey.Seed()))
+		assert.Equal(t, "3c24da049451555d51a7014a37337aa4e12d41e485abccfa46b47dfb2af54b7a",
+			hex.EncodeToString(signer.PrivateKey.Public().(ed25519.PublicKey)))
+	})
+	t.Run("DeriveSolanaSigners", func(t *testing.T) {
+		signers, err := DeriveSolanaSigners("test test test test test test test test test test test junk", "", "m/44'/501'/0-4'/0'")
+		assert.Nil(t, err)
+		assert.Len(t, signers, 5)
+		assert.Equal(t, "m/44'/501'/3'/0'", signers[3].DerivationPath)
+	})
+	t.Run("NonHardened", func(t *testing.T) {
+		_, err := DeriveSolanaSigner("test test test test test test test test test test test junk", "", "m/44'/501'/0'/0")
+		assert.NotNil(t, err)
+	})
+	t.Run("Base58", func(t *testing.T) {
+		assert.Equal(t, "11111111111111111111111111111111", base58Encode(make([]byte, 32)))
+		assert.Equal(t, "2NEpo7TZRRrLZSi2U", base58Encode([]byte("Hello World!")))
+	})
+}
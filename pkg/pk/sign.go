@@ -0,0 +1,122 @@
+package pk
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const typedDataDomainType = "EIP712Domain"
+
+// PersonalMessageHash returns the EIP-191 (version 0x45) hash used by personal_sign.
+func PersonalMessageHash(message []byte) []byte {
+	return accounts.TextHash(message)
+}
+
+// TypedDataHash returns the EIP-712 digest of typedData together with its domain separator.
+func TypedDataHash(typedData apitypes.TypedData) (hash []byte, domainSeparator []byte, err error) {
+	typedData = NormalizeTypedData(typedData)
+	domainSeparator, err = typedData.HashStruct(typedDataDomainType, typedData.Domain.Map())
+	if err != nil {
+		return nil, nil, err
+	}
+	messageHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return nil, nil, err
+	}
+	rawData := append([]byte{0x19, 0x01}, domainSeparator...)
+	rawData = append(rawData, messageHash...)
+	return crypto.Keccak256(rawData), domainSeparator, nil
+}
+
+// SignHash signs a 32 byte digest and returns the signature in [R || S || V] form with V in {27, 28}.
+func SignHash(privateKey *ecdsa.PrivateKey, hash []byte) ([]byte, error) {
+	if privateKey == nil {
+		return nil, errors.New("SignHash Failed: private key is nil")
+	}
+	signature, err := crypto.Sign(hash, privateKey)
+	if err != nil {
+		return nil, err
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
+
+// SignPersonalMessage implements personal_sign (EIP-191).
+func SignPersonalMessage(privateKey *ecdsa.PrivateKey, message []byte) ([]byte, error) {
+	return SignHash(privateKey, PersonalMessageHash(message))
+}
+
+// SignTypedData implements eth_signTypedData_v4 (EIP-712).
+func SignTypedData(privateKey *ecdsa.PrivateKey, typedData apitypes.TypedData) ([]byte, error) {
+	hash, _, err := TypedDataHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return SignHash(privateKey, hash)
+}
+
+// RecoverHashSigner recovers the address that produced signature over hash.
+// Both {0, 1} and {27, 28} recovery ids are accepted.
+func RecoverHashSigner(hash []byte, signature []byte) (common.Address, error) {
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("invalid signature length: %d", len(signature))
+	}
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	publicKey, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*publicKey), nil
+}
+
+func RecoverPersonalSigner(message []byte, signature []byte) (common.Address, error) {
+	return RecoverHashSigner(PersonalMessageHash(message), signature)
+}
+
+func RecoverTypedDataSigner(typedData apitypes.TypedData, signature []byte) (common.Address, error) {
+	hash, _, err := TypedDataHash(typedData)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return RecoverHashSigner(hash, signature)
+}
+
+// NormalizeTypedData fills in the EIP712Domain type from the populated domain fields when it is missing.
+func NormalizeTypedData(typedData apitypes.TypedData) apitypes.TypedData {
+	if _, ok := typedData.Types[typedDataDomainType]; ok {
+		return typedData
+	}
+	domainType := make([]apitypes.Type, 0)
+	if len(typedData.Domain.Name) > 0 {
+		domainType = append(domainType, apitypes.Type{Name: "name", Type: "string"})
+	}
+	if len(typedData.Domain.Version) > 0 {
+		domainType = append(domainType, apitypes.Type{Name: "version", Type: "string"})
+	}
+	if typedData.Domain.ChainId != nil {
+		domainType = append(domainType, apitypes.Type{Name: "chainId", Type: "uint256"})
+	}
+	if len(typedData.Domain.VerifyingContract) > 0 {
+		domainType = append(domainType, apitypes.Type{Name: "verifyingContract", Type: "address"})
+	}
+	if len(typedData.Domain.Salt) > 0 {
+		domainType = append(domainType, apitypes.Type{Name: "salt", Type: "bytes32"})
+	}
+	types := make(apitypes.Types, len(typedData.Types)+1)
+	for k, v := range typedData.Types {
+		types[k] = v
+	}
+	types[typedDataDomainType] = domainType
+	typedData.Types = types
+	return typedData
+}
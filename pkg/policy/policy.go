@@ -0,0 +1,311 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/6boris/web3-go/consts"
+	"github.com/6boris/web3-go/erc/erc1155"
+	"github.com/6boris/web3-go/erc/erc20"
+	"github.com/6boris/web3-go/erc/erc721"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/6boris/web3-go/pkg/otel"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var ErrDenied = errors.New("signing policy denied")
+
+// DeniedError is returned when a transaction violates the signer policy, it matches ErrDenied with errors.Is.
+type DeniedError struct {
+	Signer common.Address
+	Rule   string
+	Reason string
+}
+
+func (e *DeniedError) Error() string {
+	return fmt.Sprintf("signing policy denied: signer %s rule %s: %s", e.Signer, e.Rule, e.Reason)
+}
+func (e *DeniedError) Is(target error) bool {
+	return target == ErrDenied
+}
+
+type usage struct {
+	at     time.Time
+	amount *big.Int
+}
+
+// Engine evaluates outgoing transactions of a single signer. Amounts are reserved against the
+// rolling windows as soon as a transaction passes, whether or not it is broadcast afterwards.
+type Engine struct {
+	mu         sync.Mutex
+	conf       *clientModel.ConfEvmSignerPolicy
+	signer     common.Address
+	recipients map[common.Address]struct{}
+	methods    map[common.Address]map[[4]byte]struct{}
+	usages     map[string][]usage
+	now        func() time.Time
+}
+
+func NewEngine(signer common.Address, conf *clientModel.ConfEvmSignerPolicy) (*Engine, error) {
+	e := &Engine{
+		conf:       conf,
+		signer:     signer,
+		recipients: make(map[common.Address]struct{}, len(conf.RecipientAllowlist)),
+		methods:    make(map[common.Address]map[[4]byte]struct{}, len(conf.MethodAllowlist)),
+		usages:     make(map[string][]usage),
+		now:        time.Now,
+	}
+	for _, v := range conf.RecipientAllowlist {
+		e.recipients[v] = struct{}{}
+	}
+	for _, v := range conf.MethodAllowlist {
+		if _, ok := e.methods[v.Contract]; !ok {
+			e.methods[v.Contract] = make(map[[4]byte]struct{}, len(v.Methods))
+		}
+		for _, m := range v.Methods {
+			selector, err := parseSelector(m)
+			if err != nil {
+				return nil, err
+			}
+			e.methods[v.Contract][selector] = struct{}{}
+		}
+	}
+	return e, nil
+}
+
+func (e *Engine) DryRun() bool {
+	return e.conf.DryRun
+}
+
+// Call is a single call authorized by a signature, To is nil for contract creation.
+type Call struct {
+	To    *common.Address
+	Value *big.Int
+	Data  []byte
+}
+
+// Evaluate checks tx against every rule. In dry-run mode denials are only counted in metrics.
+func (e *Engine) Evaluate(ctx context.Context, chainID *big.Int, tx *types.Transaction) error {
+	return e.EvaluateCalls(ctx, chainID, []Call{{To: tx.To(), Value: tx.Value(), Data: tx.Data()}})
+}
+
+// EvaluateCalls checks the calls authorized by a single signature, such as the calls of a smart account
+// UserOperation. Either every call passes and their amounts are reserved together, or none does.
+func (e *Engine) EvaluateCalls(ctx context.Context, chainID *big.Int, calls []Call) error {
+	return e._record(ctx, chainID, func(now time.Time) (map[string]*big.Int, error) {
+		return e._evaluateCalls(calls, now)
+	})
+}
+
+// _record runs evaluate under the engine lock, counts denials and reserves the amounts it returns.
+func (e *Engine) _record(ctx context.Context, chainID *big.Int, evaluate func(now time.Time) (map[string]*big.Int, error)) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	now := e.now()
+	reservations, err := evaluate(now)
+	if err != nil {
+		var deniedErr *DeniedError
+		if errors.As(err, &deniedErr) {
+			var chainIDValue int64
+			if chainID != nil {
+				chainIDValue = chainID.Int64()
+			}
+			otel.MetricsWeb3PolicyDeniedCounter.Add(ctx, 1, metric.WithAttributes(
+				attribute.Key("signer").String(e.signer.String()),
+				attribute.Key("chain_id").Int64(chainIDValue),
+				attribute.Key("rule").String(deniedErr.Rule),
+				attribute.Key("dry_run").Bool(e.conf.DryRun),
+			))
+		}
+		if !e.conf.DryRun {
+			return err
+		}
+	}
+	for asset, amount := range reservations {
+		e.usages[asset] = append(e.usages[asset], usage{at: now, amount: amount})
+	}
+	return nil
+}
+
+func (e *Engine) _evaluateCalls(calls []Call, now time.Time) (map[string]*big.Int, error) {
+	reservations := make(map[string]*big.Int)
+	for _, call := range calls {
+		if err := e._evaluate(call, now, reservations); err != nil {
+			return nil, err
+		}
+	}
+	return reservations, nil
+}
+
+func (e *Engine) _evaluate(call Call, now time.Time, reservations map[string]*big.Int) error {
+	data, value := call.Data, call.Value
+	if value == nil {
+		value = new(big.Int)
+	}
+	if call.To == nil {
+		if len(e.methods) > 0 {
+			return e._deny(consts.PolicyRuleMethodAllowlist, "contract creation is not allowed")
+		}
+	} else if len(data) > 0 {
+		if err := e._checkMethod(*call.To, data); err != nil {
+			return err
+		}
+	}
+	if value.Sign() > 0 || (call.To != nil && len(data) == 0) {
+		if call.To != nil {
+			if err := e._checkRecipient(*call.To); err != nil {
+				return err
+			}
+		}
+		if err := e._checkLimit(e.conf.NativeLimit, "native", value, reservations, now,
+			consts.PolicyRuleNativeTxLimit, consts.PolicyRuleNativeWindowLimit); err != nil {
+			return err
+		}
+		reservations["native"] = _add(reservations["native"], value)
+	}
+	if call.To != nil && len(data) >= 4 {
+		recipient, amount, ok := decodeTokenMovement(data)
+		if ok {
+			if err := e._checkRecipient(recipient); err != nil {
+				return err
+			}
+			if limit, exist := e.conf.TokenLimits[*call.To]; exist && amount != nil {
+				asset := call.To.String()
+				if err := e._checkLimit(limit, asset, amount, reservations, now,
+					consts.PolicyRuleTokenTxLimit, consts.PolicyRuleTokenWindowLimit); err != nil {
+					return err
+				}
+				reservations[asset] = _add(reservations[asset], amount)
+			}
+		}
+	}
+	return nil
+}
+
+func (e *Engine) _checkMethod(contract common.Address, data []byte) error {
+	if len(e.methods) == 0 {
+		return nil
+	}
+	if len(data) < 4 {
+		return e._deny(consts.PolicyRuleMethodAllowlist, fmt.Sprintf("calldata too short: %s", hexutil.Encode(data)))
+	}
+	var selector [4]byte
+	copy(selector[:], data[:4])
+	for _, c := range []common.Address{contract, {}} {
+		if methods, ok := e.methods[c]; ok {
+			if _, allowed := methods[selector]; allowed {
+				return nil
+			}
+		}
+	}
+	return e._deny(consts.PolicyRuleMethodAllowlist, fmt.Sprintf("method %s on %s is not allowed", hexutil.Encode(selector[:]), contract))
+}
+func (e *Engine) _checkRecipient(recipient common.Address) error {
+	if len(e.recipients) == 0 {
+		return nil
+	}
+	if _, ok := e.recipients[recipient]; !ok {
+		return e._deny(consts.PolicyRuleRecipientAllowlist, fmt.Sprintf("recipient %s is not allowed", recipient))
+	}
+	return nil
+}
+
+// _checkLimit counts pending, the amount reserved by earlier calls of the same signature, against the window.
+func (e *Engine) _checkLimit(limit *clientModel.ConfEvmValueLimit, asset string, amount *big.Int, pending map[string]*big.Int, now time.Time, txRule, windowRule string) error {
+	if limit == nil {
+		return nil
+	}
+	if !limit.PerTx.IsZero() && amount.Cmp(limit.PerTx.BigInt()) > 0 {
+		return e._deny(txRule, fmt.Sprintf("%s amount %s exceeds per tx limit %s", asset, amount, limit.PerTx))
+	}
+	if limit.PerWindow.IsZero() || limit.Window <= 0 {
+		return nil
+	}
+	spent := _add(nil, pending[asset])
+	kept := e.usages[asset][:0]
+	for _, v := range e.usages[asset] {
+		if now.Sub(v.at) < limit.Window {
+			kept = append(kept, v)
+			spent.Add(spent, v.amount)
+		}
+	}
+	e.usages[asset] = kept
+	if spent.Add(spent, amount).Cmp(limit.PerWindow.BigInt()) > 0 {
+		return e._deny(windowRule, fmt.Sprintf("%s amount %s exceeds window limit %s within %s", asset, amount, limit.PerWindow, limit.Window))
+	}
+	return nil
+}
+func (e *Engine) _deny(rule string, reason string) error {
+	return &DeniedError{Signer: e.signer, Rule: rule, Reason: reason}
+}
+
+// decodeTokenMovement extracts the counterparty and amount of token calls that move or expose funds.
+// Selectors shared between ERC-20 and ERC-721 decode as ERC-20. NFT transfers and operator approvals
+// return a nil amount, they only go through the recipient allowlist.
+func decodeTokenMovement(data []byte) (common.Address, *big.Int, bool) {
+	for _, meta := range []*bind.MetaData{erc20.ERC20MetaData, erc721.ERC721MetaData, erc1155.ERC1155MetaData} {
+		abi, err := meta.GetAbi()
+		if err != nil {
+			return common.Address{}, nil, false
+		}
+		method, err := abi.MethodById(data[:4])
+		if err != nil {
+			continue
+		}
+		args, err := method.Inputs.Unpack(data[4:])
+		if err != nil {
+			return common.Address{}, nil, false
+		}
+		switch method.RawName {
+		case "transfer", "approve", "increaseAllowance":
+			return args[0].(common.Address), args[1].(*big.Int), true
+		case "transferFrom":
+			return args[1].(common.Address), args[2].(*big.Int), true
+		case "safeTransferFrom", "safeBatchTransferFrom":
+			return args[1].(common.Address), nil, true
+		case "setApprovalForAll":
+			return args[0].(common.Address), nil, args[1].(bool)
+		}
+		return common.Address{}, nil, false
+	}
+	return common.Address{}, nil, false
+}
+
+func _add(a *big.Int, b *big.Int) *big.Int {
+	sum := new(big.Int)
+	if a != nil {
+		sum.Add(sum, a)
+	}
+	if b != nil {
+		sum.Add(sum, b)
+	}
+	return sum
+}
+
+func parseSelector(method string) ([4]byte, error) {
+	var selector [4]byte
+	if strings.HasPrefix(method, "0x") {
+		b, err := hexutil.Decode(method)
+		if err != nil || len(b) != 4 {
+			return selector, fmt.Errorf("invalid method selector: %s", method)
+		}
+		copy(selector[:], b)
+		return selector, nil
+	}
+	if !strings.Contains(method, "(") {
+		return selector, fmt.Errorf("invalid method signature: %s", method)
+	}
+	copy(selector[:], crypto.Keccak256([]byte(strings.ReplaceAll(method, " ", "")))[:4])
+	return selector, nil
+}
@@ -0,0 +1,203 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/6boris/web3-go/consts"
+	"github.com/6boris/web3-go/erc/erc1155"
+	"github.com/6boris/web3-go/erc/erc20"
+	"github.com/6boris/web3-go/erc/erc721"
+	"github.com/6boris/web3-go/erc/safe"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicy_Unite_Engine(t *testing.T) {
+	ctx := context.TODO()
+	chainID := big.NewInt(137)
+	signer := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	alice := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	mallory := common.HexToAddress("0x3000000000000000000000000000000000000003")
+	usdt := common.HexToAddress("0xc2132D05D31c914a87C6611C10748AEb04B58e8F")
+	erc20Abi, err := erc20.ERC20MetaData.GetAbi()
+	assert.Nil(t, err)
+	nativeTx := func(to common.Address, value int64) *types.Transaction {
+		return types.NewTx(&types.LegacyTx{To: &to, Value: big.NewInt(value), Gas: 21000, GasPrice: big.NewInt(1)})
+	}
+	tokenTx := func(method string, args ...interface{}) *types.Transaction {
+		data, err := erc20Abi.Pack(method, args...)
+		assert.Nil(t, err)
+		return types.NewTx(&types.LegacyTx{To: &usdt, Value: big.NewInt(0), Gas: 60000, GasPrice: big.NewInt(1), Data: data})
+	}
+	assertDenied := func(t *testing.T, err error, rule string) {
+		var deniedErr *DeniedError
+		assert.True(t, errors.As(err, &deniedErr))
+		assert.ErrorIs(t, err, ErrDenied)
+		assert.Equal(t, rule, deniedErr.Rule)
+	}
+	conf := &clientModel.ConfEvmSignerPolicy{
+		RecipientAllowlist: []common.Address{alice},
+		MethodAllowlist: []*clientModel.ConfEvmContractMethods{
+			{Contract: usdt, Methods: []string{"transfer(address,uint256)", "0x095ea7b3"}},
+		},
+		NativeLimit: &clientModel.ConfEvmValueLimit{PerTx: decimal.NewFromInt(100), PerWindow: decimal.NewFromInt(150), Window: time.Hour},
+		TokenLimits: map[common.Address]*clientModel.ConfEvmValueLimit{
+			usdt: {PerTx: decimal.NewFromInt(10), PerWindow: decimal.NewFromInt(15), Window: time.Hour},
+		},
+	}
+
+	t.Run("Recipient", func(t *testing.T) {
+		engine, err := NewEngine(signer, conf)
+		assert.Nil(t, err)
+		assert.Nil(t, engine.Evaluate(ctx, chainID, nativeTx(alice, 1)))
+		assertDenied(t, engine.Evaluate(ctx, chainID, nativeTx(mallory, 1)), consts.PolicyRuleRecipientAllowlist)
+		assertDenied(t, engine.Evaluate(ctx, chainID, tokenTx("transfer", mallory, big.NewInt(1))), consts.PolicyRuleRecipientAllowlist)
+	})
+	t.Run("Method", func(t *testing.T) {
+		engine, err := NewEngine(signer, conf)
+		assert.Nil(t, err)
+		assert.Nil(t, engine.Evaluate(ctx, chainID, tokenTx("approve", alice, big.NewInt(1))))
+		assertDenied(t, engine.Evaluate(ctx, chainID, tokenTx("transferFrom", mallory, alice, big.NewInt(1))), consts.PolicyRuleMethodAllowlist)
+		assertDenied(t, engine.Evaluate(ctx, chainID, types.NewTx(&types.LegacyTx{Data: []byte{0x60, 0x80}})), consts.PolicyRuleMethodAllowlist)
+	})
+	t.Run("NativeLimit", func(t *testing.T) {
+		engine, err := NewEngine(signer, conf)
+		assert.Nil(t, err)
+		now := time.Now()
+		engine.now = func() time.Time { return now }
+		assertDenied(t, engine.Evaluate(ctx, chainID, nativeTx(alice, 101)), consts.PolicyRuleNativeTxLimit)
+		assert.Nil(t, engine.Evaluate(ctx, chainID, nativeTx(alice, 100)))
+		assertDenied(t, engine.Evaluate(ctx, chainID, nativeTx(alice, 51)), consts.PolicyRuleNativeWindowLimit)
+		assert.Nil(t, engine.Evaluate(ctx, chainID, nativeTx(alice, 50)))
+		now = now.Add(time.Hour)
+		assert.Nil(t, engine.Evaluate(ctx, chainID, nativeTx(alice, 100)))
+	})
+	t.Run("TokenLimit", func(t *testing.T) {
+		engine, err := NewEngine(signer, conf)
+		assert.Nil(t, err)
+		assertDenied(t, engine.Evaluate(ctx, chainID, tokenTx("transfer", alice, big.NewInt(11))), consts.PolicyRuleTokenTxLimit)
+		assert.Nil(t, engine.Evaluate(ctx, chainID, tokenTx("transfer", alice, big.NewInt(10))))
+		assertDenied(t, engine.Evaluate(ctx, chainID, tokenTx("approve", alice, big.NewInt(6))), consts.PolicyRuleTokenWindowLimit)
+	})
+	t.Run("DryRun", func(t *testing.T) {
+		engine, err := NewEngine(signer, &clientModel.ConfEvmSignerPolicy{DryRun: true, RecipientAllowlist: []common.Address{alice}})
+		assert.Nil(t, err)
+		assert.True(t, engine.DryRun())
+		assert.Nil(t, engine.Evaluate(ctx, chainID, nativeTx(mallory, 1)))
+	})
+	t.Run("NFT", func(t *testing.T) {
+		engine, err := NewEngine(signer, &clientModel.ConfEvmSignerPolicy{RecipientAllowlist: []common.Address{alice}})
+		assert.Nil(t, err)
+		erc721Abi, err := erc721.ERC721MetaData.GetAbi()
+		assert.Nil(t, err)
+		erc1155Abi, err := erc1155.ERC1155MetaData.GetAbi()
+		assert.Nil(t, err)
+		nftTx := func(data []byte, err error) *types.Transaction {
+			assert.Nil(t, err)
+			return types.NewTx(&types.LegacyTx{To: &usdt, Value: big.NewInt(0), Gas: 60000, GasPrice: big.NewInt(1), Data: data})
+		}
+		assert.Nil(t, engine.Evaluate(ctx, chainID, nftTx(erc721Abi.Pack("safeTransferFrom", signer, alice, big.NewInt(1)))))
+		assertDenied(t, engine.Evaluate(ctx, chainID, nftTx(erc721Abi.Pack("safeTransferFrom0", signer, mallory, big.NewInt(1), []byte{}))), consts.PolicyRuleRecipientAllowlist)
+		assertDenied(t, engine.Evaluate(ctx, chainID, nftTx(erc721Abi.Pack("setApprovalForAll", mallory, true))), consts.PolicyRuleRecipientAllowlist)
+		assert.Nil(t, engine.Evaluate(ctx, chainID, nftTx(erc721Abi.Pack("setApprovalForAll", mallory, false))))
+		assertDenied(t, engine.Evaluate(ctx, chainID, nftTx(erc1155Abi.Pack("safeTransferFrom", signer, mallory, big.NewInt(1), big.NewInt(5), []byte{}))), consts.PolicyRuleRecipientAllowlist)
+		assertDenied(t, engine.Evaluate(ctx, chainID, nftTx(erc1155Abi.Pack("safeBatchTransferFrom", signer, mallory, []*big.Int{big.NewInt(1)}, []*big.Int{big.NewInt(5)}, []byte{}))), consts.PolicyRuleRecipientAllowlist)
+	})
+	t.Run("TypedData", func(t *testing.T) {
+		engine, err := NewEngine(signer, conf)
+		assert.Nil(t, err)
+		permit := func(spender common.Address, value string) apitypes.TypedData {
+			return apitypes.TypedData{
+				PrimaryType: "Permit",
+				Domain:      apitypes.TypedDataDomain{Name: "USDT", VerifyingContract: usdt.Hex()},
+				Message:     apitypes.TypedDataMessage{"owner": signer.Hex(), "spender": spender.Hex(), "value": value, "nonce": "0", "deadline": "1"},
+			}
+		}
+		assert.Nil(t, engine.EvaluateTypedData(ctx, permit(alice, "10")))
+		assertDenied(t, engine.EvaluateTypedData(ctx, permit(mallory, "1")), consts.PolicyRuleRecipientAllowlist)
+		assertDenied(t, engine.EvaluateTypedData(ctx, permit(alice, "11")), consts.PolicyRuleTokenTxLimit)
+		assertDenied(t, engine.EvaluateTypedData(ctx, apitypes.TypedData{
+			PrimaryType: "PermitBatch",
+			Message: apitypes.TypedDataMessage{
+				"details": []interface{}{
+					map[string]interface{}{"token": usdt.Hex(), "amount": "3", "expiration": "1", "nonce": "0"},
+					map[string]interface{}{"token": usdt.Hex(), "amount": "3", "expiration": "1", "nonce": "0"},
+				},
+				"spender":     alice.Hex(),
+				"sigDeadline": "1",
+			},
+		}), consts.PolicyRuleTokenWindowLimit)
+		assertDenied(t, engine.EvaluateTypedData(ctx, apitypes.TypedData{PrimaryType: "Order"}), consts.PolicyRuleSignature)
+	})
+	t.Run("SafeTx", func(t *testing.T) {
+		engine, err := NewEngine(signer, &clientModel.ConfEvmSignerPolicy{RecipientAllowlist: []common.Address{alice}})
+		assert.Nil(t, err)
+		multiSendAbi, err := safe.MultiSendMetaData.GetAbi()
+		assert.Nil(t, err)
+		safeTx := func(to common.Address, data []byte, operation string) apitypes.TypedData {
+			return apitypes.TypedData{
+				PrimaryType: "SafeTx",
+				Message:     apitypes.TypedDataMessage{"to": to.Hex(), "value": "1", "data": hexutil.Encode(data), "operation": operation},
+			}
+		}
+		multiSend := func(to common.Address) []byte {
+			var transactions []byte
+			transactions = append(transactions, 0)
+			transactions = append(transactions, to.Bytes()...)
+			transactions = append(transactions, common.LeftPadBytes([]byte{1}, 32)...)
+			transactions = append(transactions, make([]byte, 32)...)
+			data, err := multiSendAbi.Pack("multiSend", transactions)
+			assert.Nil(t, err)
+			return data
+		}
+		multiSendCallOnly := common.HexToAddress("0x40A2aCCbd92BCA938b02010E17A5b8929b49130D")
+		assert.Nil(t, engine.EvaluateTypedData(ctx, safeTx(alice, nil, "0")))
+		assertDenied(t, engine.EvaluateTypedData(ctx, safeTx(mallory, nil, "0")), consts.PolicyRuleRecipientAllowlist)
+		assert.Nil(t, engine.EvaluateTypedData(ctx, safeTx(multiSendCallOnly, multiSend(alice), "1")))
+		assertDenied(t, engine.EvaluateTypedData(ctx, safeTx(multiSendCallOnly, multiSend(mallory), "1")), consts.PolicyRuleRecipientAllowlist)
+		assertDenied(t, engine.EvaluateTypedData(ctx, safeTx(mallory, multiSend(alice), "1")), consts.PolicyRuleSignature)
+	})
+	t.Run("Hash", func(t *testing.T) {
+		engine, err := NewEngine(signer, conf)
+		assert.Nil(t, err)
+		assertDenied(t, engine.EvaluateHash(ctx, make([]byte, 32)), consts.PolicyRuleSignature)
+	})
+	t.Run("UserOperation", func(t *testing.T) {
+		engine, err := NewEngine(signer, conf)
+		assert.Nil(t, err)
+		transfer := func(amount int64) []byte {
+			data, err := erc20Abi.Pack("transfer", alice, big.NewInt(amount))
+			assert.Nil(t, err)
+			return data
+		}
+		executeBatch := simpleAccountAbi.Methods["executeBatch0"]
+		batch, err := executeBatch.Inputs.Pack([]common.Address{usdt, alice}, []*big.Int{big.NewInt(0), big.NewInt(50)}, [][]byte{transfer(5), {}})
+		assert.Nil(t, err)
+		assert.Nil(t, engine.EvaluateUserOperation(ctx, chainID, append(executeBatch.ID, batch...)))
+		batch, err = executeBatch.Inputs.Pack([]common.Address{usdt, usdt}, []*big.Int{big.NewInt(0), big.NewInt(0)}, [][]byte{transfer(5), transfer(6)})
+		assert.Nil(t, err)
+		assertDenied(t, engine.EvaluateUserOperation(ctx, chainID, append(executeBatch.ID, batch...)), consts.PolicyRuleTokenWindowLimit)
+
+		executeBatchV06 := simpleAccountAbi.Methods["executeBatch"]
+		batch, err = executeBatchV06.Inputs.Pack([]common.Address{mallory}, [][]byte{{}})
+		assert.Nil(t, err)
+		assertDenied(t, engine.EvaluateUserOperation(ctx, chainID, append(executeBatchV06.ID, batch...)), consts.PolicyRuleRecipientAllowlist)
+		assertDenied(t, engine.EvaluateUserOperation(ctx, chainID, transfer(1)), consts.PolicyRuleSignature)
+		assert.Nil(t, engine.EvaluateUserOperation(ctx, chainID, nil))
+	})
+	t.Run("InvalidMethod", func(t *testing.T) {
+		_, err := NewEngine(signer, &clientModel.ConfEvmSignerPolicy{
+			MethodAllowlist: []*clientModel.ConfEvmContractMethods{{Methods: []string{"transfer"}}},
+		})
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,301 @@
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/6boris/web3-go/consts"
+	"github.com/6boris/web3-go/erc/erc20"
+	"github.com/6boris/web3-go/erc/safe"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// multiSendAddresses are the Safe MultiSend deployments a SafeTx may delegate call, their inner calls
+// are evaluated one by one.
+var multiSendAddresses = map[common.Address]struct{}{
+	common.HexToAddress("0xA238CBeb142c10Ef7Ad8442C6D1f9E89e07e7761"): {},
+	common.HexToAddress("0x40A2aCCbd92BCA938b02010E17A5b8929b49130D"): {},
+}
+
+// simpleAccountAbi holds the execute methods of the reference ERC-4337 SimpleAccount, executeBatch has a
+// v0.6 form without values and a v0.7 form with them.
+var simpleAccountAbi = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(`[
+		{"type":"function","name":"execute","inputs":[{"name":"dest","type":"address"},{"name":"value","type":"uint256"},{"name":"func","type":"bytes"}],"outputs":[]},
+		{"type":"function","name":"executeBatch","inputs":[{"name":"dest","type":"address[]"},{"name":"func","type":"bytes[]"}],"outputs":[]},
+		{"type":"function","name":"executeBatch","inputs":[{"name":"dest","type":"address[]"},{"name":"value","type":"uint256[]"},{"name":"func","type":"bytes[]"}],"outputs":[]}
+	]`))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}()
+
+// EvaluateTypedData checks an EIP-712 message before it is signed. EIP-2612 and Permit2 permits are
+// evaluated as the ERC-20 approve they grant, a SafeTx as the calls the Safe will make. Any other
+// message is denied, its effect cannot be evaluated.
+func (e *Engine) EvaluateTypedData(ctx context.Context, typedData apitypes.TypedData) error {
+	return e._record(ctx, (*big.Int)(typedData.Domain.ChainId), func(now time.Time) (map[string]*big.Int, error) {
+		calls, err := _typedDataCalls(typedData)
+		if err != nil {
+			return nil, e._deny(consts.PolicyRuleSignature, fmt.Sprintf("typed data %s cannot be evaluated: %s", typedData.PrimaryType, err))
+		}
+		return e._evaluateCalls(calls, now)
+	})
+}
+
+// EvaluateHash denies signing a raw hash, nothing tells what it authorizes.
+func (e *Engine) EvaluateHash(ctx context.Context, hash []byte) error {
+	return e._record(ctx, nil, func(now time.Time) (map[string]*big.Int, error) {
+		_ = now
+		return nil, e._deny(consts.PolicyRuleSignature, fmt.Sprintf("raw hash %s cannot be evaluated", hexutil.Encode(hash)))
+	})
+}
+
+// EvaluateUserOperation checks the callData of an ERC-4337 user operation before its hash is signed, it
+// is evaluated as the calls the account executes. Call data other than the SimpleAccount execute methods
+// is denied, an empty one only deploys the account.
+func (e *Engine) EvaluateUserOperation(ctx context.Context, chainID *big.Int, callData []byte) error {
+	return e._record(ctx, chainID, func(now time.Time) (map[string]*big.Int, error) {
+		calls, err := _userOperationCalls(callData)
+		if err != nil {
+			return nil, e._deny(consts.PolicyRuleSignature, fmt.Sprintf("user operation cannot be evaluated: %s", err))
+		}
+		return e._evaluateCalls(calls, now)
+	})
+}
+
+func _userOperationCalls(callData []byte) ([]Call, error) {
+	if len(callData) == 0 {
+		return nil, nil
+	}
+	if len(callData) < 4 {
+		return nil, errors.New("call data too short")
+	}
+	method, err := simpleAccountAbi.MethodById(callData[:4])
+	if err != nil {
+		return nil, errors.New("unknown account method")
+	}
+	args, err := method.Inputs.Unpack(callData[4:])
+	if err != nil {
+		return nil, err
+	}
+	if method.RawName == "execute" {
+		to := args[0].(common.Address)
+		return []Call{{To: &to, Value: args[1].(*big.Int), Data: args[2].([]byte)}}, nil
+	}
+	dest := args[0].([]common.Address)
+	data := args[len(args)-1].([][]byte)
+	values := make([]*big.Int, len(dest))
+	if len(args) == 3 {
+		values = args[1].([]*big.Int)
+	}
+	if len(data) != len(dest) || len(values) != len(dest) {
+		return nil, errors.New("wrong batch length")
+	}
+	calls := make([]Call, 0, len(dest))
+	for i := range dest {
+		value := values[i]
+		if value == nil {
+			value = new(big.Int)
+		}
+		calls = append(calls, Call{To: &dest[i], Value: value, Data: data[i]})
+	}
+	return calls, nil
+}
+
+func _typedDataCalls(typedData apitypes.TypedData) ([]Call, error) {
+	message := map[string]interface{}(typedData.Message)
+	switch typedData.PrimaryType {
+	case "Permit":
+		if !common.IsHexAddress(typedData.Domain.VerifyingContract) {
+			return nil, errors.New("domain has no verifying contract")
+		}
+		spender, err := _typedAddress(message["spender"])
+		if err != nil {
+			return nil, err
+		}
+		// DAI style permits grant an unlimited allowance through allowed instead of a value.
+		if allowed, ok := message["allowed"]; ok {
+			value := new(big.Int)
+			if allowed == true || allowed == "true" {
+				value = math.MaxBig256
+			}
+			return _approveCalls(common.HexToAddress(typedData.Domain.VerifyingContract), spender, value)
+		}
+		value, err := _typedBig(message["value"])
+		if err != nil {
+			return nil, err
+		}
+		return _approveCalls(common.HexToAddress(typedData.Domain.VerifyingContract), spender, value)
+	case "PermitSingle", "PermitBatch":
+		return _permit2Calls(message, "details")
+	case "PermitTransferFrom", "PermitBatchTransferFrom", "PermitWitnessTransferFrom", "PermitBatchWitnessTransferFrom":
+		return _permit2Calls(message, "permitted")
+	case "SafeTx":
+		to, err := _typedAddress(message["to"])
+		if err != nil {
+			return nil, err
+		}
+		value, err := _typedBig(message["value"])
+		if err != nil {
+			return nil, err
+		}
+		data, err := _typedBytes(message["data"])
+		if err != nil {
+			return nil, err
+		}
+		operation, err := _typedBig(message["operation"])
+		if err != nil {
+			return nil, err
+		}
+		if operation.Sign() == 0 {
+			return []Call{{To: &to, Value: value, Data: data}}, nil
+		}
+		if _, ok := multiSendAddresses[to]; !ok {
+			return nil, fmt.Errorf("delegate call to %s", to)
+		}
+		return _multiSendCalls(data)
+	}
+	return nil, errors.New("unknown primary type")
+}
+
+// _permit2Calls turns the token permissions under key, a single struct or a list, into approve calls.
+func _permit2Calls(message map[string]interface{}, key string) ([]Call, error) {
+	spender, err := _typedAddress(message["spender"])
+	if err != nil {
+		return nil, err
+	}
+	permissions, ok := message[key].([]interface{})
+	if !ok {
+		permissions = []interface{}{message[key]}
+	}
+	calls := make([]Call, 0, len(permissions))
+	for _, v := range permissions {
+		permission, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid %s", key)
+		}
+		token, err := _typedAddress(permission["token"])
+		if err != nil {
+			return nil, err
+		}
+		amount, err := _typedBig(permission["amount"])
+		if err != nil {
+			return nil, err
+		}
+		approve, err := _approveCalls(token, spender, amount)
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, approve...)
+	}
+	return calls, nil
+}
+
+func _approveCalls(token common.Address, spender common.Address, amount *big.Int) ([]Call, error) {
+	abi, err := erc20.ERC20MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	data, err := abi.Pack("approve", spender, amount)
+	if err != nil {
+		return nil, err
+	}
+	return []Call{{To: &token, Value: new(big.Int), Data: data}}, nil
+}
+
+// _multiSendCalls decodes multiSend(bytes), every entry is operation(1) to(20) value(32) length(32) data.
+func _multiSendCalls(data []byte) ([]Call, error) {
+	abi, err := safe.MultiSendMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 4 {
+		return nil, errors.New("delegate call is not multiSend")
+	}
+	method, err := abi.MethodById(data[:4])
+	if err != nil {
+		return nil, errors.New("delegate call is not multiSend")
+	}
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, err
+	}
+	transactions := args[0].([]byte)
+	calls := make([]Call, 0)
+	for len(transactions) > 0 {
+		if len(transactions) < 85 {
+			return nil, errors.New("truncated multiSend transaction")
+		}
+		if transactions[0] != 0 {
+			return nil, errors.New("delegate call inside multiSend")
+		}
+		to := common.BytesToAddress(transactions[1:21])
+		value := new(big.Int).SetBytes(transactions[21:53])
+		length := new(big.Int).SetBytes(transactions[53:85])
+		if !length.IsUint64() || length.Uint64() > uint64(len(transactions)-85) {
+			return nil, errors.New("truncated multiSend transaction")
+		}
+		end := 85 + int(length.Uint64())
+		calls = append(calls, Call{To: &to, Value: value, Data: transactions[85:end]})
+		transactions = transactions[end:]
+	}
+	return calls, nil
+}
+
+func _typedAddress(v interface{}) (common.Address, error) {
+	switch value := v.(type) {
+	case common.Address:
+		return value, nil
+	case string:
+		if common.IsHexAddress(value) {
+			return common.HexToAddress(value), nil
+		}
+	}
+	return common.Address{}, fmt.Errorf("invalid address: %v", v)
+}
+
+func _typedBig(v interface{}) (*big.Int, error) {
+	switch value := v.(type) {
+	case *big.Int:
+		return value, nil
+	case *math.HexOrDecimal256:
+		return (*big.Int)(value), nil
+	case float64:
+		if number, accuracy := big.NewFloat(value).Int(nil); accuracy == big.Exact {
+			return number, nil
+		}
+	case json.Number:
+		return _typedBig(value.String())
+	case string:
+		if number, ok := math.ParseBig256(value); ok {
+			return number, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid integer: %v", v)
+}
+
+func _typedBytes(v interface{}) ([]byte, error) {
+	switch value := v.(type) {
+	case []byte:
+		return value, nil
+	case hexutil.Bytes:
+		return value, nil
+	case string:
+		if value == "" || strings.EqualFold(value, "0x") {
+			return nil, nil
+		}
+		return hexutil.Decode(value)
+	}
+	return nil, fmt.Errorf("invalid bytes: %v", v)
+}
@@ -0,0 +1,66 @@
+package signer
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/6boris/web3-go/pkg/pk"
+	"github.com/6boris/web3-go/pkg/policy"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// PolicySigner evaluates every transaction, typed data message and user operation against a policy engine
+// before handing it to the wrapped signer. Raw hashes and personal messages are denied since nothing tells
+// what they authorize, a personal message can carry a user operation or SafeTx hash.
+type PolicySigner struct {
+	Signer
+	engine *policy.Engine
+}
+
+func WithPolicy(s Signer, engine *policy.Engine) *PolicySigner {
+	return &PolicySigner{Signer: s, engine: engine}
+}
+
+func (s *PolicySigner) SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	if err := s.engine.Evaluate(ctx, chainID, tx); err != nil {
+		return nil, err
+	}
+	return s.Signer.SignTx(ctx, tx, chainID)
+}
+func (s *PolicySigner) SignTypedData(ctx context.Context, typedData apitypes.TypedData) ([]byte, error) {
+	if err := s.engine.EvaluateTypedData(ctx, typedData); err != nil {
+		return nil, err
+	}
+	return s.Signer.SignTypedData(ctx, typedData)
+}
+func (s *PolicySigner) SignHash(ctx context.Context, hash []byte) ([]byte, error) {
+	if err := s.engine.EvaluateHash(ctx, hash); err != nil {
+		return nil, err
+	}
+	return s.Signer.SignHash(ctx, hash)
+}
+func (s *PolicySigner) SignText(ctx context.Context, message []byte) ([]byte, error) {
+	if err := s.engine.EvaluateHash(ctx, pk.PersonalMessageHash(message)); err != nil {
+		return nil, err
+	}
+	return s._signText(ctx, message)
+}
+func (s *PolicySigner) SignUserOperation(ctx context.Context, chainID *big.Int, callData []byte, userOpHash common.Hash) ([]byte, error) {
+	if err := s.engine.EvaluateUserOperation(ctx, chainID, callData); err != nil {
+		return nil, err
+	}
+	return s._signText(ctx, userOpHash.Bytes())
+}
+func (s *PolicySigner) _signText(ctx context.Context, message []byte) ([]byte, error) {
+	if textSigner, ok := s.Signer.(TextSigner); ok {
+		return textSigner.SignText(ctx, message)
+	}
+	return s.Signer.SignHash(ctx, pk.PersonalMessageHash(message))
+}
+func (s *PolicySigner) Close() {
+	if closer, ok := s.Signer.(Closer); ok {
+		closer.Close()
+	}
+}
@@ -0,0 +1,73 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"os"
+
+	"github.com/6boris/web3-go/pkg/pk"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+type PrivateKeySigner struct {
+	privateKey *ecdsa.PrivateKey
+	address    common.Address
+}
+
+func NewPrivateKeySigner(privateKey *ecdsa.PrivateKey) (*PrivateKeySigner, error) {
+	if privateKey == nil {
+		return nil, errors.New("NewPrivateKeySigner Failed: private key is nil")
+	}
+	return &PrivateKeySigner{
+		privateKey: privateKey,
+		address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+	}, nil
+}
+
+func (s *PrivateKeySigner) Address() common.Address {
+	return s.address
+}
+func (s *PrivateKeySigner) SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	_ = ctx
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), s.privateKey)
+}
+func (s *PrivateKeySigner) SignHash(ctx context.Context, hash []byte) ([]byte, error) {
+	_ = ctx
+	return pk.SignHash(s.privateKey, hash)
+}
+func (s *PrivateKeySigner) SignTypedData(ctx context.Context, typedData apitypes.TypedData) ([]byte, error) {
+	_ = ctx
+	return pk.SignTypedData(s.privateKey, typedData)
+}
+
+// KeystoreSigner is a PrivateKeySigner loaded from an encrypted geth keystore (V3) file.
+type KeystoreSigner struct {
+	*PrivateKeySigner
+	path string
+}
+
+func NewKeystoreSigner(path string, passphrase string) (*KeystoreSigner, error) {
+	keyJSON, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	s, err := NewPrivateKeySigner(key.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	return &KeystoreSigner{PrivateKeySigner: s, path: path}, nil
+}
+
+func (s *KeystoreSigner) Path() string {
+	return s.path
+}
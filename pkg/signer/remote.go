@@ -0,0 +1,149 @@
+package signer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/6boris/web3-go/consts"
+	"github.com/6boris/web3-go/pkg/pk"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// RemoteSigner delegates signing to an external signer speaking the Clef (account_*)
+// or web3signer (eth_*) JSON-RPC protocol, so the key never enters this process.
+type RemoteSigner struct {
+	rpcClient *rpc.Client
+	protocol  string
+	address   common.Address
+}
+
+func NewRemoteSigner(ctx context.Context, url string, protocol string, address common.Address) (*RemoteSigner, error) {
+	if protocol != consts.SignerTypeClef && protocol != consts.SignerTypeWeb3Signer {
+		return nil, fmt.Errorf("remote signer protocol not support: %s", protocol)
+	}
+	if address == (common.Address{}) {
+		return nil, errors.New("NewRemoteSigner Failed: address is empty")
+	}
+	rpcClient, err := rpc.DialContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	return &RemoteSigner{rpcClient: rpcClient, protocol: protocol, address: address}, nil
+}
+
+func (s *RemoteSigner) Address() common.Address {
+	return s.address
+}
+func (s *RemoteSigner) Close() {
+	s.rpcClient.Close()
+}
+
+// SignTx uses account_signTransaction (Clef) or eth_signTransaction (web3signer).
+func (s *RemoteSigner) SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	method := "eth_signTransaction"
+	if s.protocol == consts.SignerTypeClef {
+		method = "account_signTransaction"
+	}
+	var result json.RawMessage
+	if err := s.rpcClient.CallContext(ctx, &result, method, s._toSendTxArgs(tx, chainID)); err != nil {
+		return nil, err
+	}
+	// Clef answers with {"raw": "0x..", "tx": {..}}, web3signer with the raw transaction hex.
+	var raw hexutil.Bytes
+	if err := json.Unmarshal(result, &raw); err != nil {
+		reply := struct {
+			Raw hexutil.Bytes `json:"raw"`
+		}{}
+		if err = json.Unmarshal(result, &reply); err != nil {
+			return nil, err
+		}
+		raw = reply.Raw
+	}
+	signedTx := new(types.Transaction)
+	if err := signedTx.UnmarshalBinary(raw); err != nil {
+		return nil, err
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), signedTx)
+	if err != nil {
+		return nil, err
+	}
+	if sender != s.address {
+		return nil, fmt.Errorf("remote signer returned tx signed by %s, want %s", sender, s.address)
+	}
+	return signedTx, nil
+}
+
+// SignHash is refused by both Clef and web3signer, use SignText or SignTypedData instead.
+func (s *RemoteSigner) SignHash(ctx context.Context, hash []byte) ([]byte, error) {
+	_, _ = ctx, hash
+	return nil, ErrSignHashUnsupported
+}
+
+// SignText uses account_signData with text/plain (Clef) or eth_sign (web3signer).
+func (s *RemoteSigner) SignText(ctx context.Context, message []byte) ([]byte, error) {
+	var (
+		signature hexutil.Bytes
+		err       error
+	)
+	if s.protocol == consts.SignerTypeClef {
+		err = s.rpcClient.CallContext(ctx, &signature, "account_signData", "text/plain", common.NewMixedcaseAddress(s.address), hexutil.Bytes(message))
+	} else {
+		err = s.rpcClient.CallContext(ctx, &signature, "eth_sign", s.address, hexutil.Bytes(message))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return signature, nil
+}
+
+// SignTypedData uses account_signTypedData (Clef) or eth_signTypedData (web3signer).
+func (s *RemoteSigner) SignTypedData(ctx context.Context, typedData apitypes.TypedData) ([]byte, error) {
+	var (
+		signature hexutil.Bytes
+		err       error
+	)
+	typedData = pk.NormalizeTypedData(typedData)
+	if s.protocol == consts.SignerTypeClef {
+		err = s.rpcClient.CallContext(ctx, &signature, "account_signTypedData", common.NewMixedcaseAddress(s.address), typedData)
+	} else {
+		err = s.rpcClient.CallContext(ctx, &signature, "eth_signTypedData", s.address, typedData)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return signature, nil
+}
+
+func (s *RemoteSigner) _toSendTxArgs(tx *types.Transaction, chainID *big.Int) *apitypes.SendTxArgs {
+	data := hexutil.Bytes(tx.Data())
+	args := &apitypes.SendTxArgs{
+		From:    common.NewMixedcaseAddress(s.address),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   hexutil.Big(*tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    &data,
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	if tx.To() != nil {
+		to := common.NewMixedcaseAddress(*tx.To())
+		args.To = &to
+	}
+	if tx.Type() == types.LegacyTxType || tx.Type() == types.AccessListTxType {
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	} else {
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	}
+	if tx.Type() != types.LegacyTxType {
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	}
+	return args
+}
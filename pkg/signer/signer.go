@@ -0,0 +1,77 @@
+package signer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/6boris/web3-go/consts"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/6boris/web3-go/pkg/policy"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+var ErrSignHashUnsupported = errors.New("signer does not support raw hash signing")
+
+// Signer signs on behalf of a single EVM account. Signatures are returned in [R || S || V] form with V in {27, 28}.
+type Signer interface {
+	Address() common.Address
+	SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	SignHash(ctx context.Context, hash []byte) ([]byte, error)
+	SignTypedData(ctx context.Context, typedData apitypes.TypedData) ([]byte, error)
+}
+
+// TextSigner is implemented by signers that apply the EIP-191 prefix themselves, such as remote signers
+// which refuse to sign raw hashes.
+type TextSigner interface {
+	SignText(ctx context.Context, message []byte) ([]byte, error)
+}
+
+// UserOperationSigner is implemented by signers that evaluate the callData of an ERC-4337 user operation
+// before signing the EIP-191 message of its userOp hash, such as PolicySigner.
+type UserOperationSigner interface {
+	SignUserOperation(ctx context.Context, chainID *big.Int, callData []byte, userOpHash common.Hash) ([]byte, error)
+}
+
+// Closer is implemented by signers holding a connection, such as remote signers.
+type Closer interface {
+	Close()
+}
+
+func NewSignerFromConf(ctx context.Context, conf *clientModel.ConfEvmChainSigner) (Signer, error) {
+	signerType := conf.SignerType
+	if signerType == "" {
+		signerType = consts.SignerTypePrivateKey
+	}
+	var (
+		s   Signer
+		err error
+	)
+	switch signerType {
+	case consts.SignerTypePrivateKey:
+		s, err = NewPrivateKeySigner(conf.PrivateKey)
+	case consts.SignerTypeKeystore:
+		s, err = NewKeystoreSigner(conf.KeystorePath, conf.KeystorePassphrase)
+	case consts.SignerTypeClef, consts.SignerTypeWeb3Signer:
+		s, err = NewRemoteSigner(ctx, conf.RemoteURL, signerType, conf.PublicAddress)
+	default:
+		return nil, fmt.Errorf("signer type not support: %s", conf.SignerType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if conf.PublicAddress != (common.Address{}) && conf.PublicAddress != s.Address() {
+		return nil, fmt.Errorf("signer address mismatch: config %s, signer %s", conf.PublicAddress, s.Address())
+	}
+	if conf.Policy != nil {
+		engine, err := policy.NewEngine(s.Address(), conf.Policy)
+		if err != nil {
+			return nil, err
+		}
+		s = WithPolicy(s, engine)
+	}
+	return s, nil
+}
@@ -0,0 +1,187 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/6boris/web3-go/consts"
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/6boris/web3-go/pkg/pk"
+	"github.com/6boris/web3-go/pkg/policy"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type testClefService struct {
+	key *ecdsa.PrivateKey
+}
+
+func (s *testClefService) SignTransaction(args apitypes.SendTxArgs) (map[string]interface{}, error) {
+	tx, err := args.ToTransaction()
+	if err != nil {
+		return nil, err
+	}
+	signedTx, err := types.SignTx(tx, types.LatestSignerForChainID(args.ChainID.ToInt()), s.key)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := signedTx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"raw": hexutil.Bytes(raw), "tx": signedTx}, nil
+}
+func (s *testClefService) SignData(contentType string, addr common.MixedcaseAddress, data hexutil.Bytes) (hexutil.Bytes, error) {
+	_, _ = contentType, addr
+	return pk.SignPersonalMessage(s.key, data)
+}
+
+type testWeb3SignerService struct {
+	key *ecdsa.PrivateKey
+}
+
+func (s *testWeb3SignerService) SignTransaction(args apitypes.SendTxArgs) (hexutil.Bytes, error) {
+	tx, err := args.ToTransaction()
+	if err != nil {
+		return nil, err
+	}
+	signedTx, err := types.SignTx(tx, types.LatestSignerForChainID(args.ChainID.ToInt()), s.key)
+	if err != nil {
+		return nil, err
+	}
+	return signedTx.MarshalBinary()
+}
+func (s *testWeb3SignerService) SignTypedData(addr common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error) {
+	_ = addr
+	return pk.SignTypedData(s.key, typedData)
+}
+
+func TestSigner_Unite(t *testing.T) {
+	ctx := context.TODO()
+	key, err := crypto.GenerateKey()
+	assert.Nil(t, err)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	chainID := big.NewInt(137)
+	to := common.HexToAddress("0xc2132D05D31c914a87C6611C10748AEb04B58e8F")
+	unsignedTx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     7,
+		GasTipCap: big.NewInt(30000000000),
+		GasFeeCap: big.NewInt(60000000000),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(1),
+	})
+	typedData := apitypes.TypedData{
+		Types:       apitypes.Types{"Ping": []apitypes.Type{{Name: "id", Type: "uint256"}}},
+		PrimaryType: "Ping",
+		Domain:      apitypes.TypedDataDomain{Name: "web3-go", Version: "1"},
+		Message:     apitypes.TypedDataMessage{"id": "1"},
+	}
+	assertTx := func(t *testing.T, s Signer) {
+		signedTx, err := s.SignTx(ctx, unsignedTx, chainID)
+		assert.Nil(t, err)
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), signedTx)
+		assert.Nil(t, err)
+		assert.Equal(t, address, sender)
+	}
+
+	t.Run("PrivateKey", func(t *testing.T) {
+		s, err := NewSignerFromConf(ctx, &clientModel.ConfEvmChainSigner{PrivateKey: key, PublicAddress: address})
+		assert.Nil(t, err)
+		assert.Equal(t, address, s.Address())
+		assertTx(t, s)
+		signature, err := s.SignTypedData(ctx, typedData)
+		assert.Nil(t, err)
+		recovered, err := pk.RecoverTypedDataSigner(typedData, signature)
+		assert.Nil(t, err)
+		assert.Equal(t, address, recovered)
+	})
+	t.Run("Policy", func(t *testing.T) {
+		s, err := NewSignerFromConf(ctx, &clientModel.ConfEvmChainSigner{
+			PrivateKey: key,
+			Policy:     &clientModel.ConfEvmSignerPolicy{RecipientAllowlist: []common.Address{address}},
+		})
+		assert.Nil(t, err)
+		_, err = s.SignTx(ctx, unsignedTx, chainID)
+		assert.ErrorIs(t, err, policy.ErrDenied)
+		_, err = s.SignTypedData(ctx, typedData)
+		assert.ErrorIs(t, err, policy.ErrDenied)
+		_, err = s.SignHash(ctx, crypto.Keccak256([]byte("web3-go")))
+		assert.ErrorIs(t, err, policy.ErrDenied)
+		_, err = s.(TextSigner).SignText(ctx, []byte("web3-go"))
+		assert.ErrorIs(t, err, policy.ErrDenied)
+	})
+	t.Run("AddressMismatch", func(t *testing.T) {
+		_, err := NewSignerFromConf(ctx, &clientModel.ConfEvmChainSigner{PrivateKey: key, PublicAddress: to})
+		assert.NotNil(t, err)
+	})
+	t.Run("Keystore", func(t *testing.T) {
+		keyJSON, err := keystore.EncryptKey(&keystore.Key{Id: uuid.New(), Address: address, PrivateKey: key},
+			"web3-go", keystore.LightScryptN, keystore.LightScryptP)
+		assert.Nil(t, err)
+		path := filepath.Join(t.TempDir(), "key.json")
+		assert.Nil(t, os.WriteFile(path, keyJSON, 0o600))
+
+		s, err := NewSignerFromConf(ctx, &clientModel.ConfEvmChainSigner{
+			SignerType: consts.SignerTypeKeystore, KeystorePath: path, KeystorePassphrase: "web3-go",
+		})
+		assert.Nil(t, err)
+		assertTx(t, s)
+
+		_, err = NewKeystoreSigner(path, "wrong")
+		assert.NotNil(t, err)
+	})
+	t.Run("Clef", func(t *testing.T) {
+		server := rpc.NewServer()
+		assert.Nil(t, server.RegisterName("account", &testClefService{key: key}))
+		httpServer := httptest.NewServer(server)
+		defer httpServer.Close()
+
+		s, err := NewSignerFromConf(ctx, &clientModel.ConfEvmChainSigner{
+			SignerType: consts.SignerTypeClef, RemoteURL: httpServer.URL, PublicAddress: address,
+		})
+		assert.Nil(t, err)
+		assertTx(t, s)
+		message := []byte("web3-go")
+		signature, err := s.(TextSigner).SignText(ctx, message)
+		assert.Nil(t, err)
+		recovered, err := pk.RecoverPersonalSigner(message, signature)
+		assert.Nil(t, err)
+		assert.Equal(t, address, recovered)
+		_, err = s.SignHash(ctx, crypto.Keccak256(message))
+		assert.ErrorIs(t, err, ErrSignHashUnsupported)
+		closer, ok := s.(Closer)
+		assert.True(t, ok)
+		closer.Close()
+	})
+	t.Run("Web3Signer", func(t *testing.T) {
+		server := rpc.NewServer()
+		assert.Nil(t, server.RegisterName("eth", &testWeb3SignerService{key: key}))
+		httpServer := httptest.NewServer(server)
+		defer httpServer.Close()
+
+		s, err := NewSignerFromConf(ctx, &clientModel.ConfEvmChainSigner{
+			SignerType: consts.SignerTypeWeb3Signer, RemoteURL: httpServer.URL, PublicAddress: address,
+		})
+		assert.Nil(t, err)
+		assertTx(t, s)
+		signature, err := s.SignTypedData(ctx, typedData)
+		assert.Nil(t, err)
+		recovered, err := pk.RecoverTypedDataSigner(typedData, signature)
+		assert.Nil(t, err)
+		assert.Equal(t, address, recovered)
+	})
+}
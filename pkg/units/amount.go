@@ -0,0 +1,70 @@
+package units
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/shopspring/decimal"
+)
+
+var ErrTokenMismatch = errors.New("token amounts of different tokens")
+
+// TokenAmount pairs a raw on-chain value with the metadata needed to read it.
+type TokenAmount struct {
+	Raw   *big.Int
+	Token *clientModel.TokenMetadata
+}
+
+func NewTokenAmount(raw *big.Int, token *clientModel.TokenMetadata) *TokenAmount {
+	if raw == nil {
+		raw = big.NewInt(0)
+	}
+	return &TokenAmount{Raw: raw, Token: token}
+}
+
+// NewTokenAmountFromDecimal builds a TokenAmount from a human readable amount, see ToBaseUnits.
+func NewTokenAmountFromDecimal(amount decimal.Decimal, token *clientModel.TokenMetadata) (*TokenAmount, error) {
+	raw, err := ToBaseUnits(amount, int32(token.Decimals))
+	if err != nil {
+		return nil, err
+	}
+	return NewTokenAmount(raw, token), nil
+}
+
+func (a *TokenAmount) Decimal() decimal.Decimal {
+	return ToDecimal(a.Raw, int32(a.Token.Decimals))
+}
+
+func (a *TokenAmount) Add(b *TokenAmount) (*TokenAmount, error) {
+	if a.Token.ChainID != b.Token.ChainID || a.Token.Address != b.Token.Address {
+		return nil, ErrTokenMismatch
+	}
+	return NewTokenAmount(new(big.Int).Add(a.Raw, b.Raw), a.Token), nil
+}
+func (a *TokenAmount) Sub(b *TokenAmount) (*TokenAmount, error) {
+	if a.Token.ChainID != b.Token.ChainID || a.Token.Address != b.Token.Address {
+		return nil, ErrTokenMismatch
+	}
+	return NewTokenAmount(new(big.Int).Sub(a.Raw, b.Raw), a.Token), nil
+}
+
+// String renders the amount with its symbol, e.g. "1.5 USDT".
+func (a *TokenAmount) String() string {
+	if a.Token.Symbol == "" {
+		return a.Decimal().String()
+	}
+	return a.Decimal().String() + " " + a.Token.Symbol
+}
+
+func (a *TokenAmount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"raw":      a.Raw.String(),
+		"amount":   a.Decimal().String(),
+		"chainId":  a.Token.ChainID,
+		"address":  a.Token.Address,
+		"symbol":   a.Token.Symbol,
+		"decimals": a.Token.Decimals,
+	})
+}
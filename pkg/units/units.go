@@ -0,0 +1,128 @@
+package units
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	WeiDecimals     int32 = 0
+	GweiDecimals    int32 = 9
+	EtherDecimals   int32 = 18
+	LamportDecimals int32 = 0
+	SolDecimals     int32 = 9
+)
+
+var (
+	ErrInvalidAmount   = errors.New("invalid amount")
+	ErrUnknownUnit     = errors.New("unknown unit")
+	ErrPrecisionLoss   = errors.New("amount has more decimal places than the unit allows")
+	ErrNegativeAmount  = errors.New("amount is negative")
+	ErrDecimalsInvalid = errors.New("decimals out of range")
+)
+
+// unitDecimals maps unit names accepted by ParseAmount to their decimals relative to the base unit.
+var unitDecimals = map[string]int32{
+	"wei":        0,
+	"kwei":       3,
+	"babbage":    3,
+	"mwei":       6,
+	"lovelace":   6,
+	"gwei":       9,
+	"shannon":    9,
+	"szabo":      12,
+	"microether": 12,
+	"finney":     15,
+	"milliether": 15,
+	"ether":      18,
+	"eth":        18,
+	"lamport":    0,
+	"lamports":   0,
+	"sol":        9,
+}
+
+// ToDecimal converts base units to a decimal amount, 1500000000 with 9 decimals is 1.5.
+func ToDecimal(value *big.Int, decimals int32) decimal.Decimal {
+	if value == nil {
+		return decimal.Zero
+	}
+	return decimal.NewFromBigInt(value, -decimals)
+}
+
+// ToBaseUnits converts a decimal amount to base units and fails with ErrPrecisionLoss rather than
+// dropping digits that do not fit in decimals.
+func ToBaseUnits(amount decimal.Decimal, decimals int32) (*big.Int, error) {
+	if decimals < 0 || decimals > 77 {
+		return nil, ErrDecimalsInvalid
+	}
+	shifted := amount.Shift(decimals)
+	if !shifted.Equal(shifted.Truncate(0)) {
+		return nil, ErrPrecisionLoss
+	}
+	return shifted.BigInt(), nil
+}
+
+// ToBaseUnitsFloor converts a decimal amount to base units rounding toward negative infinity,
+// so a payout computed from it never exceeds amount.
+func ToBaseUnitsFloor(amount decimal.Decimal, decimals int32) *big.Int {
+	return amount.Shift(decimals).Floor().BigInt()
+}
+
+// ToBaseUnitsCeil converts a decimal amount to base units rounding toward positive infinity,
+// useful for fees and allowances that must cover amount.
+func ToBaseUnitsCeil(amount decimal.Decimal, decimals int32) *big.Int {
+	return amount.Shift(decimals).Ceil().BigInt()
+}
+
+func WeiToGwei(wei *big.Int) decimal.Decimal {
+	return ToDecimal(wei, GweiDecimals)
+}
+func WeiToEther(wei *big.Int) decimal.Decimal {
+	return ToDecimal(wei, EtherDecimals)
+}
+func GweiToWei(gwei decimal.Decimal) (*big.Int, error) {
+	return ToBaseUnits(gwei, GweiDecimals)
+}
+func EtherToWei(ether decimal.Decimal) (*big.Int, error) {
+	return ToBaseUnits(ether, EtherDecimals)
+}
+func LamportsToSol(lamports *big.Int) decimal.Decimal {
+	return ToDecimal(lamports, SolDecimals)
+}
+func SolToLamports(sol decimal.Decimal) (*big.Int, error) {
+	return ToBaseUnits(sol, SolDecimals)
+}
+
+// ParseAmount parses "<number> <unit>" into base units, e.g. "1.5 gwei" is 1500000000 wei and
+// "0.25 sol" is 250000000 lamports. A number without unit is taken as base units.
+func ParseAmount(s string) (*big.Int, error) {
+	fields := strings.Fields(strings.TrimSpace(s))
+	if len(fields) == 0 || len(fields) > 2 {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+	decimals := int32(0)
+	if len(fields) == 2 {
+		var ok bool
+		decimals, ok = unitDecimals[strings.ToLower(fields[1])]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownUnit, fields[1])
+		}
+	}
+	amount, err := decimal.NewFromString(fields[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+	if amount.IsNegative() {
+		return nil, ErrNegativeAmount
+	}
+	return ToBaseUnits(amount, decimals)
+}
+
+// FormatUnits renders base units as a decimal string without trailing zeros.
+func FormatUnits(value *big.Int, decimals int32) string {
+	return ToDecimal(value, decimals).String()
+}
@@ -0,0 +1,76 @@
+package units
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	clientModel "github.com/6boris/web3-go/model/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnits_Unite(t *testing.T) {
+	t.Run("ToDecimal", func(t *testing.T) {
+		assert.Equal(t, "1.5", WeiToGwei(big.NewInt(1500000000)).String())
+		assert.Equal(t, "0.000000000000000001", WeiToEther(big.NewInt(1)).String())
+		assert.Equal(t, "2.5", LamportsToSol(big.NewInt(2500000000)).String())
+		assert.Equal(t, "0", ToDecimal(nil, 18).String())
+	})
+	t.Run("ToBaseUnits", func(t *testing.T) {
+		wei, err := EtherToWei(decimal.RequireFromString("1.000000000000000001"))
+		assert.Nil(t, err)
+		assert.Equal(t, "1000000000000000001", wei.String())
+		_, err = EtherToWei(decimal.RequireFromString("0.0000000000000000001"))
+		assert.ErrorIs(t, err, ErrPrecisionLoss)
+		lamports, err := SolToLamports(decimal.RequireFromString("0.25"))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(250000000), lamports.Int64())
+
+		amount := decimal.RequireFromString("1.2345678")
+		assert.Equal(t, int64(1234567), ToBaseUnitsFloor(amount, 6).Int64())
+		assert.Equal(t, int64(1234568), ToBaseUnitsCeil(amount, 6).Int64())
+		assert.Equal(t, int64(-1234568), ToBaseUnitsFloor(amount.Neg(), 6).Int64())
+	})
+	t.Run("ParseAmount", func(t *testing.T) {
+		cases := map[string]string{
+			"1.5 gwei":      "1500000000",
+			"2 ether":       "2000000000000000000",
+			"0.1 ETH":       "100000000000000000",
+			"21000":         "21000",
+			"0.5 sol":       "500000000",
+			"5000 lamports": "5000",
+			" 3 finney ":    "3000000000000000",
+		}
+		for in, want := range cases {
+			got, err := ParseAmount(in)
+			assert.Nil(t, err, in)
+			assert.Equal(t, want, got.String(), in)
+		}
+		_, err := ParseAmount("1.5 wei")
+		assert.ErrorIs(t, err, ErrPrecisionLoss)
+		_, err = ParseAmount("1 btc")
+		assert.ErrorIs(t, err, ErrUnknownUnit)
+		_, err = ParseAmount("one gwei")
+		assert.ErrorIs(t, err, ErrInvalidAmount)
+		_, err = ParseAmount("-1 gwei")
+		assert.ErrorIs(t, err, ErrNegativeAmount)
+	})
+	t.Run("TokenAmount", func(t *testing.T) {
+		usdt := &clientModel.TokenMetadata{ChainID: 1, Address: common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7"), Symbol: "USDT", Decimals: 6}
+		a, err := NewTokenAmountFromDecimal(decimal.RequireFromString("1.5"), usdt)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1500000), a.Raw.Int64())
+		assert.Equal(t, "1.5 USDT", a.String())
+		sum, err := a.Add(NewTokenAmount(big.NewInt(500000), usdt))
+		assert.Nil(t, err)
+		assert.Equal(t, "2", sum.Decimal().String())
+		_, err = a.Sub(NewTokenAmount(big.NewInt(1), &clientModel.TokenMetadata{ChainID: 137, Address: usdt.Address, Decimals: 6}))
+		assert.ErrorIs(t, err, ErrTokenMismatch)
+
+		raw, err := json.Marshal(a)
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{"raw":"1500000","amount":"1.5","chainId":1,"address":"0xdac17f958d2ee523a2206206994597c13d831ec7","symbol":"USDT","decimals":6}`, string(raw))
+	})
+}